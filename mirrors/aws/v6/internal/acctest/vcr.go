@@ -6,32 +6,26 @@ package acctest
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/xml"
+	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
-	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/errs/sdkdiag"
-	tfjson "github.com/blampe/patches/mirrors/aws/v6/internal/json"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/provider"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/vcr"
-	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
@@ -135,93 +129,15 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Real transport config, cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		if tlsConfig := transport.TLSClientConfig; tlsConfig == nil {
-			tlsConfig = &tls.Config{
-				MinVersion: tls.VersionTLS13,
-			}
-			transport.TLSClientConfig = tlsConfig
-		}
-
-		// After capture hook to remove sensitive HTTP headers.
-		sensitiveHeaderHook := func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-			return nil
-		}
-
-		// Define how VCR will match requests to stored interactions.
-		matchFunc := func(r *http.Request, i cassette.Request) bool {
-			if r.Method != i.Method {
-				return false
-			}
-
-			if r.URL.String() != i.URL {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				return tfjson.EqualStrings(body, i.Body)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml any
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]any{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
 
-			return false
+		var optFns []vcr.RecorderOption
+		if vcr.StrictReplay() {
+			optFns = append(optFns, vcr.WithStrictReplay())
 		}
 
-		cassetteName := filepath.Join(vcr.Path(), vcrFileName(testName))
-
 		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.New(cassetteName,
-			recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
-			recorder.WithMatcher(matchFunc),
-			recorder.WithMode(vcrMode),
-			recorder.WithRealTransport(httpClient.Transport),
-			recorder.WithSkipRequestLatency(true),
-		)
+		r, err := vcr.NewRecorder(ctx, cassetteName, vcrMode, nil, optFns...)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -230,7 +146,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
+		httpClient := &http.Client{Transport: r}
 		if v, ok := provider.Meta().(*conns.AWSClient); ok {
 			meta = v
 		} else {
@@ -256,6 +172,7 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 // In RECORD_ONLY mode, generates a new seed and saves it to a file, using the
 // seed for the source.
 // In REPLAY_ONLY mode, reads a seed from a file and creates a source from it.
+// In REPLAY_OR_RECORD mode, reads a seed from a file if there is one, otherwise generates a new seed.
 func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	t.Helper()
 	testName := t.Name()
@@ -274,6 +191,21 @@ func vcrRandomnessSource(t *testing.T) (*randomnessSource, error) {
 	}
 
 	switch vcrMode {
+	case recorder.ModeReplayWithNewEpisodes:
+		seed, err := readSeedFromFile(vcrSeedFile(vcr.Path(), testName))
+
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				return nil, err
+			}
+
+			seed = rand.Int63()
+		}
+
+		s = &randomnessSource{
+			seed:   seed,
+			source: rand.NewSource(seed),
+		}
 	case recorder.ModeRecordOnly:
 		seed := rand.Int63()
 		s = &randomnessSource{
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/blampe/patches/mirrors/aws/v6/internal/backoff"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
 )
 
 // DefaultPollInterval is the default fixed polling interval used when no custom IntervalStrategy is provided.
@@ -101,7 +102,8 @@ func WaitForStatus[T any](ctx context.Context, fetch FetchFunc[T], opts Options[
 
 	normalizeOptions(&opts)
 
-	start := time.Now()
+	clk := clock.FromContext(ctx)
+	start := clk.Now()
 	deadline := start.Add(opts.Timeout)
 	var lastProgress time.Time
 	var attempt uint
@@ -120,7 +122,7 @@ func WaitForStatus[T any](ctx context.Context, fetch FetchFunc[T], opts Options[
 		}
 
 		// Early return: timeout exceeded
-		if time.Now().After(deadline) {
+		if clk.Now().After(deadline) {
 			return last, &TimeoutError{LastStatus: last.Status, Timeout: opts.Timeout}
 		}
 
@@ -138,10 +140,10 @@ func WaitForStatus[T any](ctx context.Context, fetch FetchFunc[T], opts Options[
 		}
 
 		// Handle progress reporting
-		handleProgressReport(opts, fr, start, deadline, attempt, &lastProgress)
+		handleProgressReport(clk, opts, fr, start, deadline, attempt, &lastProgress)
 
 		// Sleep until next attempt, with context cancellation check
-		if err := sleepWithContext(ctx, opts.Interval.NextPoll(attempt)); err != nil {
+		if err := sleepWithContext(ctx, clk, opts.Interval.NextPoll(attempt)); err != nil {
 			return last, err // Early return: context cancelled during sleep
 		}
 
@@ -218,37 +220,35 @@ func classifyStatus[T any](fr FetchResult[T], opts Options[T], successStreak *in
 }
 
 // handleProgressReport sends progress updates if conditions are met.
-func handleProgressReport[T any](opts Options[T], fr FetchResult[T], start time.Time, deadline time.Time, attempt uint, lastProgress *time.Time) {
+func handleProgressReport[T any](clk clock.Clock, opts Options[T], fr FetchResult[T], start time.Time, deadline time.Time, attempt uint, lastProgress *time.Time) {
 	if opts.ProgressSink == nil || opts.ProgressInterval <= 0 {
 		return
 	}
 
-	if lastProgress.IsZero() || time.Since(*lastProgress) >= opts.ProgressInterval {
+	now := clk.Now()
+	if lastProgress.IsZero() || now.Sub(*lastProgress) >= opts.ProgressInterval {
 		nextPoll := opts.Interval.NextPoll(attempt)
 		opts.ProgressSink(anyFetchResult(fr), ProgressMeta{
 			Attempt:    attempt,
-			Elapsed:    time.Since(start),
-			Remaining:  maxDuration(0, time.Until(deadline)),
+			Elapsed:    now.Sub(start),
+			Remaining:  maxDuration(0, deadline.Sub(now)),
 			Deadline:   deadline,
 			NextPollIn: nextPoll,
 		})
-		*lastProgress = time.Now()
+		*lastProgress = now
 	}
 }
 
-// sleepWithContext sleeps for the specified duration while respecting context cancellation.
-func sleepWithContext(ctx context.Context, duration time.Duration) error {
+// sleepWithContext sleeps on the clock for the specified duration while respecting context cancellation.
+func sleepWithContext(ctx context.Context, clk clock.Clock, duration time.Duration) error {
 	if duration <= 0 {
 		return nil
 	}
 
-	timer := time.NewTimer(duration)
-	defer timer.Stop()
-
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case <-timer.C:
+	case <-clk.After(duration):
 		return nil
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/blampe/patches/mirrors/aws/v6/internal/backoff"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
 )
 
 // fastFixedInterval returns a very small fixed interval to speed tests.
@@ -190,6 +191,33 @@ func TestWaitForStatus_FetchErrorPropagation(t *testing.T) {
 	}
 }
 
+func TestWaitForStatus_VirtualClockTimeout(t *testing.T) {
+	t.Parallel()
+	start := time.Now()
+	c := clock.NewVirtual(start)
+	ctx := clock.NewContext(makeCtx(t), c)
+	var calls int32
+	_, err := WaitForStatus(ctx, func(context.Context) (FetchResult[int], error) {
+		atomic.AddInt32(&calls, 1)
+		return FetchResult[int]{Status: "PENDING"}, nil
+	}, Options[int]{
+		Timeout:       time.Hour,
+		SuccessStates: []Status{"DONE"},
+		Interval:      FixedInterval(DefaultPollInterval),
+	})
+	var te *TimeoutError
+	if !errors.As(err, &te) {
+		t.Fatalf("expected TimeoutError, got %v", err)
+	}
+	// Polls at 0s, 30s, ..., 60m.
+	if got, want := atomic.LoadInt32(&calls), int32(121); got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("wall time elapsed = %v", elapsed)
+	}
+}
+
 func TestWaitForStatus_ConsecutiveSuccess(t *testing.T) {
 	t.Parallel()
 	ctx := makeCtx(t)
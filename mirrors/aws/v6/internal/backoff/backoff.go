@@ -7,7 +7,7 @@ import (
 	"context"
 	"time"
 
-	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
 )
 
 // Inspired by https://github.com/ServiceWeaver/weaver and https://github.com/avast/retry-go.
@@ -153,34 +153,30 @@ func WithDelay(d Delay) Option {
 // WithTimer provides a way to swap out timer module implementations.
 // This primarily is useful for mocking/testing, where you may not want to explicitly wait for a set duration
 // for retries.
+// By default the loop waits using the clock carried by the context passed to Continue.
 func WithTimer(t Timer) Option {
 	return func(c *LoopConfig) {
 		c.timer = t
 	}
 }
 
-// Default timer is a wrapper around time.After
-type timerImpl struct{}
-
-func (t *timerImpl) After(d time.Duration) <-chan time.Time {
-	return time.After(d)
-}
-
 // The default RetryConfig is backwards compatible with github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry.
 func defaultLoopConfig() LoopConfig {
 	return LoopConfig{
 		delay:       DefaultSDKv2HelperRetryCompatibleDelay(),
 		gracePeriod: 30 * time.Second,
-		timer:       &timerImpl{},
 	}
 }
 
 // Loop holds state for managing loops with a timeout.
+// The loop's deadline is measured using the clock carried by the context passed to the first call to Continue.
 type Loop struct {
 	attempt     uint
+	clock       clock.Clock
 	config      LoopConfig
-	deadline    inttypes.Deadline
+	deadline    time.Time
 	gracePeriod time.Duration
+	timeout     time.Duration
 }
 
 // NewLoopWithOptions returns a new loop configured with the provided options.
@@ -192,8 +188,8 @@ func NewLoopWithOptions(timeout time.Duration, opts ...Option) *Loop {
 
 	return &Loop{
 		config:      config,
-		deadline:    inttypes.NewDeadline(timeout),
 		gracePeriod: config.gracePeriod,
+		timeout:     timeout,
 	}
 }
 
@@ -206,6 +202,10 @@ func NewLoop(timeout time.Duration) *Loop {
 // It returns false if the timeout has been exceeded.
 // The deadline is not checked on the first call to Continue.
 func (r *Loop) Continue(ctx context.Context) bool {
+	if r.clock == nil {
+		r.start(ctx)
+	}
+
 	if r.attempt != 0 && r.Remaining() == 0 {
 		// Any non-zero grace period allows one more attempt.
 		if r.gracePeriod == 0 {
@@ -228,7 +228,25 @@ func (r *Loop) Reset() {
 
 // Remaining returns how long the duration has remaining.
 func (r *Loop) Remaining() time.Duration {
-	return r.deadline.Remaining()
+	if r.clock == nil {
+		return r.timeout
+	}
+
+	if v := r.deadline.Sub(r.clock.Now()); v > 0 {
+		return v
+	}
+
+	return 0
+}
+
+// start sets the loop's clock and deadline.
+func (r *Loop) start(ctx context.Context) {
+	r.clock = clock.FromContext(ctx)
+	r.deadline = r.clock.Now().Add(r.timeout)
+
+	if r.config.timer == nil {
+		r.config.timer = r.clock
+	}
 }
 
 // sleep sleeps for the specified duration or until the context is canceled, whichever occurs first.
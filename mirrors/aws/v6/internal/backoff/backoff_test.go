@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -140,3 +141,26 @@ func TestLoopWithTimeoutNoGracePeriod(t *testing.T) {
 		t.Errorf("Iterations = %v, want %v", got, want)
 	}
 }
+
+func TestLoopWithVirtualClock(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	c := clock.NewVirtual(start)
+	ctx := clock.NewContext(t.Context(), c)
+
+	var n int
+	for r := NewLoopWithOptions(1*time.Hour, WithDelay(FixedDelay(10*time.Minute)), WithGracePeriod(0)); r.Continue(ctx); {
+		n++
+	}
+
+	if got, want := n, 7; got != want {
+		t.Errorf("Iterations = %v, want %v", got, want)
+	}
+	if got, want := c.Now().Sub(start), 1*time.Hour; got != want {
+		t.Errorf("Elapsed = %v, want %v", got, want)
+	}
+	if elapsed := time.Since(start); elapsed > 1*time.Minute {
+		t.Errorf("Wall time elapsed = %v", elapsed)
+	}
+}
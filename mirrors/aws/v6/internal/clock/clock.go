@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is the source of time for waiters and retry loops.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(time.Duration) <-chan time.Time
+}
+
+// Real is the Clock backed by the time package.
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+var _ Clock = (*Virtual)(nil)
+
+// Virtual is a Clock whose time only moves when it is advanced.
+// Waiting on a Virtual clock advances it by the waited duration and returns immediately,
+// so code that polls on a schedule runs to completion without spending wall time.
+type Virtual struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewVirtual returns a Virtual clock set to the specified time.
+func NewVirtual(now time.Time) *Virtual {
+	return &Virtual{
+		now: now,
+	}
+}
+
+// Now returns the clock's current time.
+func (c *Virtual) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// After advances the clock by the specified duration and returns a channel holding the new time.
+func (c *Virtual) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- c.Advance(d)
+
+	return ch
+}
+
+// Advance moves the clock forward by the specified duration and returns the new time.
+// Negative durations are ignored.
+func (c *Virtual) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if d > 0 {
+		c.now = c.now.Add(d)
+	}
+
+	return c.now
+}
+
+type contextKeyType int
+
+var contextKey contextKeyType
+
+// NewContext returns a copy of the specified context carrying the specified Clock.
+func NewContext(ctx context.Context, c Clock) context.Context {
+	return context.WithValue(ctx, contextKey, c)
+}
+
+// FromContext returns the Clock carried by the specified context, or Real if there is none.
+func FromContext(ctx context.Context) Clock {
+	if c, ok := ctx.Value(contextKey).(Clock); ok {
+		return c
+	}
+
+	return Real
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVirtual(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewVirtual(start)
+
+	if got, want := c.Now(), start; !got.Equal(want) {
+		t.Errorf("Now() = %v, want %v", got, want)
+	}
+
+	if got, want := <-c.After(10*time.Minute), start.Add(10*time.Minute); !got.Equal(want) {
+		t.Errorf("<-After() = %v, want %v", got, want)
+	}
+
+	if got, want := c.Advance(-1*time.Minute), start.Add(10*time.Minute); !got.Equal(want) {
+		t.Errorf("Advance() = %v, want %v", got, want)
+	}
+
+	if got, want := c.Now(), start.Add(10*time.Minute); !got.Equal(want) {
+		t.Errorf("Now() = %v, want %v", got, want)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	if got, want := FromContext(ctx), Real; got != want {
+		t.Errorf("FromContext() = %v, want %v", got, want)
+	}
+
+	c := NewVirtual(time.Now())
+	if got, want := FromContext(NewContext(ctx, c)), Clock(c); got != want {
+		t.Errorf("FromContext() = %v, want %v", got, want)
+	}
+}
@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -22,8 +24,19 @@ type withIsErrorRetryables struct {
 }
 
 func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
+	// Retry overrides take precedence over any retryables.
+	if v := isErrorRetryableOverride(err); v != aws.UnknownTernary {
+		return v.Bool()
+	}
 	if v := r.retryables.IsErrorRetryable(err); v != aws.UnknownTernary {
 		return v.Bool()
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+func (r *withIsErrorRetryables) RetryDelay(attempt int, err error) (time.Duration, error) {
+	if err := retryDelayOverride(attempt, err); err != nil {
+		return 0, err
+	}
+	return r.RetryerV2.RetryDelay(attempt, err)
+}
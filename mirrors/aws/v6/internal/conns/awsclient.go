@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,7 +32,9 @@ import (
 type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
+	bulkTagLister             *bulkTagLister            // From provider configuration.
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	concurrencyLimits         *concurrencyLimits        // From provider configuration.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
@@ -38,11 +42,13 @@ type AWSClient struct {
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	retryOverrides            map[string][]RetryOverride // From provider configuration.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	tagDriftDiagnostics       bool   // From provider configuration.
 	tagPolicyConfig           *tftags.TagPolicyConfig
 	terraformVersion          string // From provider configuration.
 }
@@ -75,14 +81,31 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
-func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
+// DefaultTagsConfig returns the default tags configuration.
+// Within a resource's Context any rules scoped to the resource's type or service package are applied.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if v, ok := FromContext(ctx); ok {
+		return c.defaultTagsConfig.ForResource(v.TypeName(), v.ServicePackageName())
+	}
+
 	return c.defaultTagsConfig
 }
 
-func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
+// IgnoreTagsConfig returns the ignore tags configuration.
+// Within a resource's Context any rules scoped to the resource's type or service package are applied.
+func (c *AWSClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
+	if v, ok := FromContext(ctx); ok {
+		return c.ignoreTagsConfig.ForResource(v.TypeName(), v.ServicePackageName())
+	}
+
 	return c.ignoreTagsConfig
 }
 
+// TagDriftDiagnostics returns whether out-of-band changes to resource tags are reported during refresh.
+func (c *AWSClient) TagDriftDiagnostics(context.Context) bool {
+	return c.tagDriftDiagnostics
+}
+
 func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
@@ -344,6 +367,21 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
+	if apiOptions, retryOverrides := c.apiOptions(ctx, servicePackageName), c.effectiveRetryOverrides(ctx, servicePackageName); (len(apiOptions) > 0 || len(retryOverrides) > 0) && c.awsConfig != nil {
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), apiOptions...)
+		if len(retryOverrides) > 0 {
+			cfg.APIOptions = append(cfg.APIOptions, retryOverridesAPIOption(retryOverrides))
+			// Service packages that customize retries wrap the configured Retryer using AddIsErrorRetryables,
+			// which honors retry overrides. Wrap it here too for those that don't.
+			if retryer := cfg.Retryer; retryer != nil {
+				cfg.Retryer = func() aws.Retryer {
+					return AddIsErrorRetryables(retryer().(aws.RetryerV2))
+				}
+			}
+		}
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -360,6 +398,24 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// apiOptions returns the provider-level middleware to add to the AWS API clients for the specified service.
+func (c *AWSClient) apiOptions(ctx context.Context, servicePackageName string) []func(*middleware.Stack) error {
+	var apiOptions []func(*middleware.Stack) error
+
+	if c.concurrencyLimits != nil {
+		if v := c.concurrencyLimits.apiOption(servicePackageName); v != nil {
+			apiOptions = append(apiOptions, v)
+		}
+	}
+	if v, ok := c.ServicePackage(ctx, servicePackageName).(ServicePackageWithLogRedactions); ok {
+		if redactions := v.LogRedactions(ctx); len(redactions) > 0 {
+			apiOptions = append(apiOptions, withLogRedactions(redactions))
+		}
+	}
+
+	return apiOptions
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+)
+
+const (
+	// bulkTagsMaxARNs is the maximum number of ARNs in a GetResources request.
+	bulkTagsMaxARNs = 100
+	// bulkTagsWindow is how long a batch collects ARNs before it is sent.
+	bulkTagsWindow = 50 * time.Millisecond
+	// bulkTagsTTL is how long resource tags are cached.
+	bulkTagsTTL = time.Minute
+)
+
+// bulkTagsGetter returns the tags of the resources with the specified ARNs.
+// Resources without tags may be absent from the result.
+type bulkTagsGetter func(ctx context.Context, arns []string) (map[string]map[string]string, error)
+
+// bulkTagLister batches lookups of resource tags, e.g. through the Resource Groups Tagging API's
+// GetResources operation, and caches the results for a short time.
+type bulkTagLister struct {
+	mu      sync.Mutex
+	cache   map[string]bulkTagsCacheEntry // ARN -> tags.
+	pending map[string]*bulkTagsBatch     // Region -> batch collecting ARNs.
+	ttl     time.Duration
+	window  time.Duration
+}
+
+type bulkTagsCacheEntry struct {
+	tags    map[string]string
+	expires time.Time
+}
+
+type bulkTagsBatch struct {
+	arns []string
+	full chan struct{} // Closed when the batch can't take more ARNs.
+	done chan struct{} // Closed when the batch's result is available.
+	tags map[string]map[string]string
+	err  error
+}
+
+func newBulkTagLister() *bulkTagLister {
+	return &bulkTagLister{
+		cache:   make(map[string]bulkTagsCacheEntry),
+		pending: make(map[string]*bulkTagsBatch),
+		ttl:     bulkTagsTTL,
+		window:  bulkTagsWindow,
+	}
+}
+
+// listTags returns the tags of the resource with the specified ARN, batching the lookup with those of
+// other resources in the same Region.
+// A resource that is absent from the result, e.g. because it doesn't exist, or isn't indexed yet, gives a NotFoundError.
+func (l *bulkTagLister) listTags(ctx context.Context, region, resourceARN string, getter bulkTagsGetter) (map[string]string, error) {
+	l.mu.Lock()
+	if entry, ok := l.cache[resourceARN]; ok && time.Now().Before(entry.expires) {
+		l.mu.Unlock()
+		return entry.tags, nil
+	}
+
+	batch, ok := l.pending[region]
+	if !ok {
+		batch = &bulkTagsBatch{
+			full: make(chan struct{}),
+			done: make(chan struct{}),
+		}
+		l.pending[region] = batch
+		// The batch's lookup isn't canceled with the context of the first resource in the batch.
+		go l.run(context.WithoutCancel(ctx), region, batch, getter)
+	}
+	if !slices.Contains(batch.arns, resourceARN) {
+		batch.arns = append(batch.arns, resourceARN)
+	}
+	if len(batch.arns) == bulkTagsMaxARNs {
+		delete(l.pending, region)
+		close(batch.full)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-batch.done:
+	}
+
+	if batch.err != nil {
+		return nil, batch.err
+	}
+
+	if tags, ok := batch.tags[resourceARN]; ok {
+		return tags, nil
+	}
+	return nil, &retry.NotFoundError{
+		Message: fmt.Sprintf("resource (%s) not found in bulk tags", resourceARN),
+	}
+}
+
+func (l *bulkTagLister) run(ctx context.Context, region string, batch *bulkTagsBatch, getter bulkTagsGetter) {
+	select {
+	case <-batch.full:
+	case <-time.After(l.window):
+		l.mu.Lock()
+		if l.pending[region] == batch {
+			delete(l.pending, region)
+		}
+		l.mu.Unlock()
+	}
+
+	// No ARNs are added to the batch once it's no longer pending.
+	tflog.Debug(ctx, "Listing tags in bulk", map[string]any{
+		"bulk_tags_arns": len(batch.arns),
+	})
+	batch.tags, batch.err = getter(ctx, batch.arns)
+
+	if batch.err == nil {
+		l.mu.Lock()
+		expires := time.Now().Add(l.ttl)
+		for _, resourceARN := range batch.arns {
+			// Missing resources aren't cached.
+			tags, ok := batch.tags[resourceARN]
+			if !ok {
+				continue
+			}
+			l.cache[resourceARN] = bulkTagsCacheEntry{
+				tags:    tags,
+				expires: expires,
+			}
+		}
+		l.mu.Unlock()
+	}
+
+	close(batch.done)
+}
+
+// invalidate removes the cached tags of the resource with the specified ARN.
+func (l *bulkTagLister) invalidate(resourceARN string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.cache, resourceARN)
+}
+
+// BulkTagRefreshEnabled returns whether resource tags are read in bulk during refresh.
+func (c *AWSClient) BulkTagRefreshEnabled(context.Context) bool {
+	return c.bulkTagLister != nil
+}
+
+// BulkListTags returns the tags of the resource with the specified ARN using the Resource Groups Tagging API.
+// Lookups from concurrent callers are batched into GetResources calls and the results cached for a short time.
+// A resource that the Resource Groups Tagging API doesn't return, e.g. because it has never been tagged,
+// gives a NotFoundError, and its tags should be listed with the service's own API.
+// The ARN's Region must be the effective AWS Region.
+func (c *AWSClient) BulkListTags(ctx context.Context, resourceARN string) (map[string]string, error) {
+	if c.bulkTagLister == nil {
+		return nil, errors.New("bulk tag refresh not enabled")
+	}
+
+	region := c.Region(ctx)
+	if v, err := arn.Parse(resourceARN); err != nil {
+		return nil, err
+	} else if v.Region != region {
+		return nil, fmt.Errorf("ARN (%s) not in Region (%s)", resourceARN, region)
+	}
+
+	conn := c.ResourceGroupsTaggingAPIClient(ctx)
+	return c.bulkTagLister.listTags(ctx, region, resourceARN, func(ctx context.Context, arns []string) (map[string]map[string]string, error) {
+		input := resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: arns,
+		}
+		m := make(map[string]map[string]string, len(arns))
+
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				tags := make(map[string]string, len(v.Tags))
+				for _, tag := range v.Tags {
+					tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+				}
+				m[aws.ToString(v.ResourceARN)] = tags
+			}
+		}
+
+		return m, nil
+	})
+}
+
+// InvalidateBulkTags discards any cached tags of the resource with the specified ARN, e.g. after they are updated.
+func (c *AWSClient) InvalidateBulkTags(_ context.Context, resourceARN string) {
+	if c.bulkTagLister != nil {
+		c.bulkTagLister.invalidate(resourceARN)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+)
+
+func TestBulkTagLister(t *testing.T) {
+	t.Parallel()
+
+	const region = "us-west-2" //lintignore:AWSAT003
+
+	ctx := t.Context()
+	l := newBulkTagLister()
+	l.window = 500 * time.Millisecond
+
+	var calls atomic.Int32
+	var sizes sync.Map
+	getter := func(_ context.Context, arns []string) (map[string]map[string]string, error) {
+		n := calls.Add(1)
+		sizes.Store(n, len(arns))
+		m := make(map[string]map[string]string)
+		for _, arn := range arns {
+			if arn != "missing" {
+				m[arn] = map[string]string{"Name": arn}
+			}
+		}
+		return m, nil
+	}
+
+	const n = bulkTagsMaxARNs + 10
+	var wg sync.WaitGroup
+	results := make([]map[string]string, n)
+	for i := range n {
+		wg.Go(func() {
+			tags, err := l.listTags(ctx, region, fmt.Sprintf("arn%d", i), getter)
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = tags
+		})
+	}
+	var missingErr error
+	wg.Go(func() {
+		_, missingErr = l.listTags(ctx, region, "missing", getter)
+	})
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("calls: got %d, want %d", got, want)
+	}
+	sizes.Range(func(_, v any) bool {
+		if v.(int) > bulkTagsMaxARNs {
+			t.Errorf("batch size: got %d, want at most %d", v, bulkTagsMaxARNs)
+		}
+		return true
+	})
+	for i := range n {
+		if got, want := results[i], map[string]string{"Name": fmt.Sprintf("arn%d", i)}; !maps.Equal(got, want) {
+			t.Errorf("tags (%d): got %v, want %v", i, got, want)
+		}
+	}
+	if !retry.NotFound(missingErr) {
+		t.Errorf("tags (missing): got error %v, want NotFoundError", missingErr)
+	}
+
+	// Missing resources aren't cached.
+	if _, err := l.listTags(ctx, region, "missing", getter); !retry.NotFound(err) {
+		t.Errorf("tags (missing): got error %v, want NotFoundError", err)
+	}
+	if got, want := calls.Load(), int32(3); got != want {
+		t.Errorf("calls after missing lookup: got %d, want %d", got, want)
+	}
+
+	// Cached.
+	if _, err := l.listTags(ctx, region, "arn0", getter); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := calls.Load(), int32(3); got != want {
+		t.Errorf("calls after cached lookup: got %d, want %d", got, want)
+	}
+
+	// Invalidated.
+	l.invalidate("arn0")
+	if _, err := l.listTags(ctx, region, "arn0", getter); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := calls.Load(), int32(4); got != want {
+		t.Errorf("calls after invalidation: got %d, want %d", got, want)
+	}
+}
+
+func TestBulkTagListerDuplicateARNs(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := newBulkTagLister()
+	l.window = 500 * time.Millisecond
+
+	var got [][]string
+	var mu sync.Mutex
+	getter := func(_ context.Context, arns []string) (map[string]map[string]string, error) {
+		mu.Lock()
+		got = append(got, arns)
+		mu.Unlock()
+		return map[string]map[string]string{"arn": {"Name": "arn"}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Go(func() {
+			if _, err := l.listTags(ctx, "us-west-2", "arn", getter); err != nil { //lintignore:AWSAT003
+				t.Error(err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if want := [][]string{{"arn"}}; !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("batches: got %v, want %v", got, want)
+	}
+}
+
+func TestBulkTagListerError(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := newBulkTagLister()
+	l.window = time.Millisecond
+
+	errBoom := errors.New("boom")
+	var calls atomic.Int32
+	getter := func(context.Context, []string) (map[string]map[string]string, error) {
+		calls.Add(1)
+		return nil, errBoom
+	}
+
+	for range 2 {
+		if _, err := l.listTags(ctx, "us-west-2", "arn", getter); !errors.Is(err, errBoom) { //lintignore:AWSAT003
+			t.Errorf("got error %v, want %v", err, errBoom)
+		}
+	}
+
+	// Errors aren't cached.
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("calls: got %d, want %d", got, want)
+	}
+}
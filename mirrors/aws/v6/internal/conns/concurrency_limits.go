@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfsync "github.com/blampe/patches/mirrors/aws/v6/internal/experimental/sync"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+// concurrencyLimits holds the semaphores gating outbound AWS API calls.
+// Limits are keyed by service package name (e.g. "route53") or by
+// service package name and API operation name (e.g. "route53:ChangeResourceRecordSets").
+type concurrencyLimits struct {
+	services   map[string]tfsync.Semaphore            // Service package name -> semaphore.
+	operations map[string]map[string]tfsync.Semaphore // Service package name -> operation name -> semaphore.
+}
+
+func newConcurrencyLimits(limits map[string]int) (*concurrencyLimits, error) {
+	if len(limits) == 0 {
+		return nil, nil
+	}
+
+	c := &concurrencyLimits{
+		services:   make(map[string]tfsync.Semaphore),
+		operations: make(map[string]map[string]tfsync.Semaphore),
+	}
+
+	for key, limit := range limits {
+		if limit < 1 {
+			return nil, fmt.Errorf("concurrency limit (%s): must be at least 1, got %d", key, limit)
+		}
+
+		servicePackageName, operation, hasOperation := strings.Cut(key, ":")
+		if _, err := names.ProviderNameUpper(servicePackageName); err != nil {
+			return nil, fmt.Errorf("concurrency limit (%s): unknown service package: %s", key, servicePackageName)
+		}
+
+		if !hasOperation {
+			c.services[servicePackageName] = tfsync.NewSemaphore(limit)
+			continue
+		}
+
+		if operation == "" {
+			return nil, fmt.Errorf("concurrency limit (%s): empty operation name", key)
+		}
+		if _, ok := c.operations[servicePackageName]; !ok {
+			c.operations[servicePackageName] = make(map[string]tfsync.Semaphore)
+		}
+		c.operations[servicePackageName][operation] = tfsync.NewSemaphore(limit)
+	}
+
+	return c, nil
+}
+
+// apiOption returns an API option that installs the concurrency limiter for the specified service,
+// or nil if the service has no concurrency limits.
+func (c *concurrencyLimits) apiOption(servicePackageName string) func(*middleware.Stack) error {
+	service, operations := c.services[servicePackageName], c.operations[servicePackageName]
+	if service == nil && len(operations) == 0 {
+		return nil
+	}
+
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(&concurrencyLimiter{
+			service:    service,
+			operations: operations,
+		}, middleware.After)
+	}
+}
+
+// concurrencyLimiter limits the number of concurrent calls to a service's AWS API operations.
+// A call, including any retries, holds the service semaphore and then the operation semaphore.
+type concurrencyLimiter struct {
+	service    tfsync.Semaphore
+	operations map[string]tfsync.Semaphore
+}
+
+// ID is the middleware identifier.
+func (l *concurrencyLimiter) ID() string {
+	return "PULUMI_AWS_ConcurrencyLimiter"
+}
+
+func (l *concurrencyLimiter) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+) (
+	out middleware.InitializeOutput, metadata middleware.Metadata, err error,
+) {
+	operation := middleware.GetOperationName(ctx)
+	start := time.Now()
+
+	// Semaphores are always acquired in the same order to avoid deadlock.
+	for _, semaphore := range []tfsync.Semaphore{l.service, l.operations[operation]} {
+		if semaphore == nil {
+			continue
+		}
+
+		if err := semaphore.WaitContext(ctx); err != nil {
+			return out, metadata, fmt.Errorf("waiting for concurrency limit (%s): %w", operation, err)
+		}
+		defer semaphore.Notify()
+	}
+
+	if elapsed := time.Since(start); elapsed >= time.Millisecond {
+		tflog.Debug(ctx, "Waited for concurrency limit", map[string]any{
+			"concurrency_limit_wait_ms": elapsed.Milliseconds(),
+		})
+	}
+
+	return next.HandleInitialize(ctx, in)
+}
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+func TestNewConcurrencyLimits(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		limits      map[string]int
+		expectedErr bool
+	}{
+		"empty": {},
+		"service": {
+			limits: map[string]int{names.Route53: 2},
+		},
+		"operation": {
+			limits: map[string]int{names.Route53 + ":ChangeResourceRecordSets": 1},
+		},
+		"unknown service": {
+			limits:      map[string]int{"nosuchservice": 1},
+			expectedErr: true,
+		},
+		"empty operation": {
+			limits:      map[string]int{names.Route53 + ":": 1},
+			expectedErr: true,
+		},
+		"zero limit": {
+			limits:      map[string]int{names.Route53: 0},
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newConcurrencyLimits(testCase.limits)
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Errorf("error: got %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	t.Parallel()
+
+	limits, err := newConcurrencyLimits(map[string]int{
+		names.Route53 + ":ChangeResourceRecordSets": 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if limits.apiOption(names.Organizations) != nil {
+		t.Errorf("expected no API option for %s", names.Organizations)
+	}
+
+	limiter := &concurrencyLimiter{
+		service:    limits.services[names.Route53],
+		operations: limits.operations[names.Route53],
+	}
+
+	var current, peak atomic.Int32
+	next := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+		n := current.Add(1)
+		for {
+			v := peak.Load()
+			if n <= v || peak.CompareAndSwap(v, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		current.Add(-1)
+
+		return middleware.InitializeOutput{}, middleware.Metadata{}, nil
+	})
+
+	ctx := middleware.WithOperationName(t.Context(), "ChangeResourceRecordSets")
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			if _, _, err := limiter.HandleInitialize(ctx, middleware.InitializeInput{}, next); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if got, want := peak.Load(), int32(1); got != want {
+		t.Errorf("peak concurrency: got %d, want %d", got, want)
+	}
+}
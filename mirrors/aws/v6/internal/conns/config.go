@@ -30,6 +30,8 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BulkTagRefresh                 bool
+	ConcurrencyLimits              map[string]int
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -46,6 +48,7 @@ type Config struct {
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
+	RetryOverrides                 map[string][]RetryOverride
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
@@ -56,6 +59,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagDriftDiagnostics            bool
 	TagPolicyConfig                *tftags.TagPolicyConfig
 	TerraformVersion               string
 	Token                          string
@@ -196,7 +200,19 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	// Fetch tag policy details when enforced
-	if c.TagPolicyConfig != nil {
+	if c.TagPolicyConfig != nil && c.TagPolicyConfig.Document != "" {
+		tflog.Debug(ctx, "Reading tag policy details from local document")
+		document, err := tagpolicy.ReadDocument(c.TagPolicyConfig.Document)
+		if err == nil {
+			c.TagPolicyConfig.RequiredTags, c.TagPolicyConfig.AllowedTagValues, err = tagpolicy.GetRequiredTagsFromDocument(ctx, document)
+		}
+		if err != nil {
+			diags = append(diags, errs.NewErrorDiagnostic(
+				"Reading Tag Policy Document",
+				fmt.Sprintf("Failed to read required tags from the local tag policy document.\n\nOriginal error: %s", err)))
+			return nil, diags
+		}
+	} else if c.TagPolicyConfig != nil {
 		tflog.Debug(ctx, "Retrieving tag policy details")
 		reqTags, err := tagpolicy.GetRequiredTags(ctx, cfg)
 		if err != nil {
@@ -210,9 +226,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		c.TagPolicyConfig.RequiredTags = reqTags
 	}
 
+	concurrencyLimits, err := newConcurrencyLimits(c.ConcurrencyLimits)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := validateRetryOverrides(c.RetryOverrides); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	client.accountID = accountID
+	if c.BulkTagRefresh {
+		client.bulkTagLister = newBulkTagLister()
+	}
+	client.concurrencyLimits = concurrencyLimits
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.tagDriftDiagnostics = c.TagDriftDiagnostics
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
@@ -221,6 +251,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.retryOverrides = c.RetryOverrides
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -6,9 +6,12 @@ package conns
 import (
 	"context"
 	"iter"
+	"time"
 
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
 	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
 // ServicePackage is the minimal interface exported from each AWS service package.
@@ -96,6 +99,13 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName, t
 		vcrEnabled:         vcr.IsEnabled(),
 	}
 
+	// Replayed interactions happen instantly, so run waiters and retry loops on a virtual clock.
+	if v.vcrEnabled {
+		if mode, _ := vcr.Mode(); mode == recorder.ModeReplayOnly {
+			ctx = clock.NewContext(ctx, clock.NewVirtual(time.Now()))
+		}
+	}
+
 	return context.WithValue(ctx, contextKey, &v)
 }
 
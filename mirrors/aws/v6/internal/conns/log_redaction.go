@@ -0,0 +1,334 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/aws-sdk-go-base/v2/logging"
+)
+
+// LogRedaction declares how the HTTP request and response bodies of an AWS API operation are written to the debug log.
+//
+// Field paths are dot-separated, e.g. "Parameter.Value".
+// For JSON bodies each path segment names an object member and arrays are traversed transparently.
+// For XML bodies the final path segment names the element whose text is redacted.
+// For form-encoded (Query protocol) bodies a path matches a parameter of the same name or with the path as a suffix.
+// If a body with redacted fields cannot be parsed, the entire body is redacted.
+type LogRedaction struct {
+	// Operation is the AWS API operation name, e.g. "PutSecretValue".
+	Operation string
+	// RequestBody redacts the entire request body. The body is not read.
+	RequestBody bool
+	// RequestFields are the paths of the request body fields to redact.
+	RequestFields []string
+	// ResponseBody redacts the entire response body. The body is not read.
+	ResponseBody bool
+	// ResponseFields are the paths of the response body fields to redact.
+	ResponseFields []string
+	// MaxBodyLength, if positive, truncates the logged request and response bodies.
+	MaxBodyLength int
+}
+
+// ServicePackageWithLogRedactions is an interface that extends ServicePackage with log redactions.
+// The redactions apply to all AWS API clients created for the service package.
+type ServicePackageWithLogRedactions interface {
+	ServicePackage
+	LogRedactions(context.Context) []LogRedaction
+}
+
+const (
+	requestResponseLoggerID = "TF_AWS_RequestResponseLogger"
+	redactedValue           = "[REDACTED]"
+)
+
+// withLogRedactions returns an API option that replaces the aws-sdk-go-base request/response logging middleware
+// with one that applies the specified redactions.
+func withLogRedactions(redactions []LogRedaction) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// The logging middleware isn't added to every client, e.g. when it's been replaced already.
+		if _, ok := stack.Deserialize.Get(requestResponseLoggerID); !ok {
+			return nil
+		}
+
+		wrapped, err := stack.Deserialize.Remove(requestResponseLoggerID)
+		if err != nil {
+			return err
+		}
+
+		return stack.Deserialize.Add(&redactingRequestResponseLogger{
+			wrapped:    wrapped,
+			redactions: redactions,
+		}, middleware.After)
+	}
+}
+
+// redactingRequestResponseLogger replaces the aws-sdk-go-base logging middleware (https://github.com/hashicorp/aws-sdk-go-base/blob/main/logger.go).
+// Operations without a redaction are passed through to the wrapped middleware.
+type redactingRequestResponseLogger struct {
+	wrapped    middleware.DeserializeMiddleware
+	redactions []LogRedaction
+}
+
+// ID is the middleware identifier.
+func (r *redactingRequestResponseLogger) ID() string {
+	return "PULUMI_AWS_RequestResponseLogger"
+}
+
+func (r *redactingRequestResponseLogger) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler,
+) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	operation := awsmiddleware.GetOperationName(ctx)
+	i := slices.IndexFunc(r.redactions, func(v LogRedaction) bool {
+		return v.Operation == operation
+	})
+	if i == -1 {
+		return r.wrapped.HandleDeserialize(ctx, in, next)
+	}
+	redaction := r.redactions[i]
+
+	logger := logging.RetrieveLogger(ctx)
+	region := awsmiddleware.GetRegion(ctx)
+
+	if signingRegion := awsmiddleware.GetSigningRegion(ctx); signingRegion != region { //nolint:staticcheck // Not retrievable elsewhere
+		ctx = logger.SetField(ctx, string(logging.SigningRegionKey), signingRegion)
+	}
+	if awsmiddleware.GetEndpointSource(ctx) == aws.EndpointSourceCustom {
+		ctx = logger.SetField(ctx, string(logging.CustomEndpointKey), true)
+	}
+
+	smithyRequest, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, fmt.Errorf("unknown request type %T", in.Request)
+	}
+
+	rc := smithyRequest.Build(ctx)
+
+	var body string
+	if redaction.RequestBody {
+		body = redactedBodyPlaceholder(rc.ContentLength)
+	} else if rc.Body != nil {
+		content, err := io.ReadAll(rc.Body)
+		if err != nil {
+			return out, metadata, fmt.Errorf("reading request body: %w", err)
+		}
+
+		smithyRequest, err = smithyRequest.SetStream(bytes.NewReader(content))
+		if err != nil {
+			return out, metadata, err
+		}
+		in.Request = smithyRequest
+
+		body = truncateBody(redactBody(content, rc.Header.Get("Content-Type"), redaction.RequestFields), redaction.MaxBodyLength)
+	}
+
+	// Log a copy of the request with the redacted body.
+	logRequest := rc.Clone(ctx)
+	logRequest.Body = io.NopCloser(strings.NewReader(body))
+	logRequest.ContentLength = int64(len(body))
+
+	requestFields, err := logging.DecomposeHTTPRequest(ctx, logRequest)
+	if err != nil {
+		return out, metadata, fmt.Errorf("decomposing request: %w", err)
+	}
+	logger.Debug(ctx, "HTTP Request Sent", requestFields)
+
+	start := time.Now()
+
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+
+	elapsed := time.Since(start)
+
+	if err == nil {
+		smithyResponse, ok := out.RawResponse.(*smithyhttp.Response)
+		if !ok {
+			return out, metadata, fmt.Errorf("unknown response type: %T", out.RawResponse)
+		}
+
+		responseFields, err := decomposeRedactedHTTPResponse(smithyResponse.Response, elapsed, redaction)
+		if err != nil {
+			return out, metadata, fmt.Errorf("decomposing response: %w", err)
+		}
+		logger.Debug(ctx, "HTTP Response Received", responseFields)
+	}
+
+	return out, metadata, err
+}
+
+func decomposeRedactedHTTPResponse(resp *http.Response, elapsed time.Duration, redaction LogRedaction) (map[string]any, error) {
+	fields := map[string]any{
+		"http.duration":    elapsed.Milliseconds(),
+		"http.status_code": resp.StatusCode,
+	}
+	if resp.ContentLength > 0 {
+		fields["http.response_content_length"] = resp.ContentLength
+	}
+	for _, attribute := range logging.DecomposeResponseHeaders(resp) {
+		fields[string(attribute.Key)] = attribute.Value.AsInterface()
+	}
+
+	if redaction.ResponseBody {
+		fields["http.response.body"] = redactedBodyPlaceholder(resp.ContentLength)
+
+		return fields, nil
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restore the body reader.
+	resp.Body = io.NopCloser(bytes.NewBuffer(content))
+
+	maxBodyLength := logging.MaxResponseBodyLen
+	if redaction.MaxBodyLength > 0 {
+		maxBodyLength = min(maxBodyLength, redaction.MaxBodyLength)
+	}
+
+	reader := textproto.NewReader(bufio.NewReader(strings.NewReader(redactBody(content, resp.Header.Get("Content-Type"), redaction.ResponseFields))))
+	body, err := logging.ReadTruncatedBody(reader, maxBodyLength)
+	if err != nil {
+		return nil, err
+	}
+	fields["http.response.body"] = body
+
+	return fields, nil
+}
+
+func redactedBodyPlaceholder(length int64) string {
+	if length < 0 {
+		return redactedValue
+	}
+
+	return fmt.Sprintf("[REDACTED: %d bytes]", length)
+}
+
+func truncateBody(body string, maxLength int) string {
+	if maxLength <= 0 || len(body) <= maxLength {
+		return body
+	}
+
+	return body[:maxLength] + "[truncated...]"
+}
+
+// redactBody returns the body with the values of the specified fields redacted.
+func redactBody(body []byte, contentType string, fields []string) string {
+	if len(fields) == 0 || len(body) == 0 {
+		return string(body)
+	}
+
+	var (
+		redacted string
+		err      error
+	)
+	switch mediaType := strings.ToLower(contentType); {
+	case strings.Contains(mediaType, "json"):
+		redacted, err = redactJSONBody(body, fields)
+	case strings.Contains(mediaType, "xml"):
+		redacted = redactXMLBody(body, fields)
+	case strings.Contains(mediaType, "x-www-form-urlencoded"):
+		redacted, err = redactFormBody(body, fields)
+	default:
+		err = fmt.Errorf("unsupported content type: %q", contentType)
+	}
+
+	if err != nil {
+		return redactedBodyPlaceholder(int64(len(body)))
+	}
+
+	return redacted
+}
+
+func redactJSONBody(body []byte, fields []string) (string, error) {
+	// Decode numbers as json.Number so that they are written back unchanged.
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return "", err
+	}
+	if decoder.More() {
+		return "", errors.New("unexpected data after JSON value")
+	}
+
+	for _, field := range fields {
+		redactJSONValue(v, strings.Split(field, "."))
+	}
+
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(redacted), nil
+}
+
+func redactJSONValue(v any, path []string) {
+	switch v := v.(type) {
+	case map[string]any:
+		child, ok := v[path[0]]
+		if !ok {
+			return
+		}
+		if len(path) == 1 {
+			v[path[0]] = redactedValue
+			return
+		}
+		redactJSONValue(child, path[1:])
+	case []any:
+		for _, e := range v {
+			redactJSONValue(e, path)
+		}
+	}
+}
+
+func redactXMLBody(body []byte, fields []string) string {
+	redacted := string(body)
+
+	for _, field := range fields {
+		name := regexp.QuoteMeta(field[strings.LastIndex(field, ".")+1:])
+		re := regexache.MustCompile(`(<` + name + `(?:\s[^>]*)?>)[^<]*(</` + name + `>)`)
+		redacted = re.ReplaceAllString(redacted, "${1}"+redactedValue+"${2}")
+	}
+
+	return redacted
+}
+
+func redactFormBody(body []byte, fields []string) (string, error) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return "", err
+	}
+
+	for key := range values {
+		if slices.ContainsFunc(fields, func(field string) bool {
+			return key == field || strings.HasSuffix(key, "."+field)
+		}) {
+			values.Set(key, redactedValue)
+		}
+	}
+
+	return values.Encode(), nil
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body        string
+		contentType string
+		fields      []string
+		expected    string
+	}{
+		"no fields": {
+			body:        `{"SecretString":"s3cr3t"}`,
+			contentType: "application/x-amz-json-1.1",
+			expected:    `{"SecretString":"s3cr3t"}`,
+		},
+		"JSON top-level": {
+			body:        `{"Name":"n","SecretString":"s3cr3t"}`,
+			contentType: "application/x-amz-json-1.1",
+			fields:      []string{"SecretString", "SecretBinary"},
+			expected:    `{"Name":"n","SecretString":"[REDACTED]"}`,
+		},
+		"JSON nested": {
+			body:        `{"Parameter":{"Name":"n","Value":"s3cr3t"}}`,
+			contentType: "application/x-amz-json-1.1",
+			fields:      []string{"Parameter.Value"},
+			expected:    `{"Parameter":{"Name":"n","Value":"[REDACTED]"}}`,
+		},
+		"JSON array": {
+			body:        `{"Parameters":[{"Name":"a","Value":"1"},{"Name":"b","Value":"2"}]}`,
+			contentType: "application/x-amz-json-1.1",
+			fields:      []string{"Parameters.Value"},
+			expected:    `{"Parameters":[{"Name":"a","Value":"[REDACTED]"},{"Name":"b","Value":"[REDACTED]"}]}`,
+		},
+		"JSON numbers": {
+			body:        `{"Count":12345678901234567890,"Ratio":0.10,"SecretString":"s3cr3t"}`,
+			contentType: "application/x-amz-json-1.1",
+			fields:      []string{"SecretString"},
+			expected:    `{"Count":12345678901234567890,"Ratio":0.10,"SecretString":"[REDACTED]"}`,
+		},
+		"JSON invalid": {
+			body:        `{"SecretString":`,
+			contentType: "application/json",
+			fields:      []string{"SecretString"},
+			expected:    "[REDACTED: 16 bytes]",
+		},
+		"XML": {
+			body:        `<Result><Secret id="1">s3cr3t</Secret><Name>n</Name></Result>`,
+			contentType: "text/xml",
+			fields:      []string{"Result.Secret"},
+			expected:    `<Result><Secret id="1">[REDACTED]</Secret><Name>n</Name></Result>`,
+		},
+		"form": {
+			body:        "Action=SetAttributes&Attributes.entry.1.Password=s3cr3t&Version=2010-03-31",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			fields:      []string{"Password"},
+			expected:    "Action=SetAttributes&Attributes.entry.1.Password=%5BREDACTED%5D&Version=2010-03-31",
+		},
+		"unknown content type": {
+			body:        "s3cr3t",
+			contentType: "application/octet-stream",
+			fields:      []string{"Secret"},
+			expected:    "[REDACTED: 6 bytes]",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := redactBody([]byte(testCase.body), testCase.contentType, testCase.fields), testCase.expected; got != want {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestWithLogRedactionsNoLogger(t *testing.T) {
+	t.Parallel()
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := withLogRedactions([]LogRedaction{{Operation: "GetSecretValue", ResponseBody: true}})(stack); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := stack.Deserialize.List(); len(got) != 0 {
+		t.Errorf("got middleware %v, want none", got)
+	}
+}
+
+func TestTruncateBody(t *testing.T) {
+	t.Parallel()
+
+	if got, want := truncateBody("abcdef", 0), "abcdef"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got, want := truncateBody("abcdef", 3), "abc[truncated...]"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -4,46 +4,190 @@
 package conns
 
 import (
+	"context"
+	"fmt"
+	"runtime"
 	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // GlobalMutexKV is a global MutexKV for use within this plugin.
 var GlobalMutexKV = newMutexKV()
 
+const (
+	// mutexKVWaitWarningInterval is how often a warning is logged while waiting for a lock.
+	mutexKVWaitWarningInterval = 1 * time.Minute
+)
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+// A key's mutex is removed from the store once it is neither held nor waited on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyedMutex
+}
+
+// keyedMutex is a mutex that can be acquired with a context.
+// Fields other than sem are guarded by the owning mutexKV's lock.
+type keyedMutex struct {
+	sem      chan struct{}
+	refs     int // Number of holders and waiters.
+	holder   string
+	acquired time.Time
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	m.get(key).Lock()
+	// A context without a deadline can't be canceled, so no error is possible.
+	_ = m.lockContext(context.Background(), key, callerName(2))
+}
+
+// LockContext locks the mutex for the given key, waiting until the lock is acquired or the context is done.
+// If the lock is acquired, the caller is responsible for calling Unlock for the same key.
+func (m *mutexKV) LockContext(ctx context.Context, key string) error {
+	return m.lockContext(ctx, key, callerName(2))
+}
+
+func (m *mutexKV) lockContext(ctx context.Context, key, caller string) error {
+	mutex := m.acquire(key)
+
+	// Fast path.
+	select {
+	case mutex.sem <- struct{}{}:
+		m.held(mutex, caller)
+		return nil
+	default:
+	}
+
+	start := time.Now()
+	holder, acquired := m.holder(mutex)
+	tflog.Debug(ctx, "Waiting for lock", map[string]any{
+		"lock_key":         key,
+		"lock_holder":      holder,
+		"lock_held_for_ms": time.Since(acquired).Milliseconds(),
+	})
+
+	ticker := time.NewTicker(mutexKVWaitWarningInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case mutex.sem <- struct{}{}:
+			m.held(mutex, caller)
+			tflog.Debug(ctx, "Acquired lock", map[string]any{
+				"lock_key":     key,
+				"lock_wait_ms": time.Since(start).Milliseconds(),
+			})
+			return nil
+		case <-ticker.C:
+			holder, acquired := m.holder(mutex)
+			tflog.Warn(ctx, "Still waiting for lock", map[string]any{
+				"lock_key":         key,
+				"lock_holder":      holder,
+				"lock_held_for_ms": time.Since(acquired).Milliseconds(),
+				"lock_wait_ms":     time.Since(start).Milliseconds(),
+			})
+		case <-ctx.Done():
+			holder, _ := m.holder(mutex)
+			m.release(key, mutex)
+			return fmt.Errorf("waiting %s for lock (%s) held by %s: %w", time.Since(start).Round(time.Millisecond), key, holder, ctx.Err())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
-	m.get(key).Unlock()
+	m.lock.Lock()
+	mutex, ok := m.store[key]
+	if ok {
+		mutex.holder = ""
+		mutex.acquired = time.Time{}
+	}
+	m.lock.Unlock()
+
+	if !ok {
+		panic(fmt.Sprintf("unlock of unlocked mutex: %s", key))
+	}
+
+	select {
+	case <-mutex.sem:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked mutex: %s", key))
+	}
+
+	m.release(key, mutex)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+// acquire returns the mutex for the given key, registering the caller as a holder or waiter.
+func (m *mutexKV) acquire(key string) *keyedMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
+
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &keyedMutex{
+			sem: make(chan struct{}, 1),
+		}
 		m.store[key] = mutex
 	}
+	mutex.refs++
+
 	return mutex
 }
 
+// release unregisters a holder or waiter, removing the key's mutex once it is idle.
+func (m *mutexKV) release(key string, mutex *keyedMutex) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex.refs--
+	if mutex.refs == 0 {
+		delete(m.store, key)
+	}
+}
+
+func (m *mutexKV) held(mutex *keyedMutex, caller string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex.holder = caller
+	mutex.acquired = time.Now()
+}
+
+func (m *mutexKV) holder(mutex *keyedMutex) (string, time.Time) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return mutex.holder, mutex.acquired
+}
+
+// len returns the number of keys in the store.
+func (m *mutexKV) len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return len(m.store)
+}
+
+// callerName returns the name of the function skip frames above the caller of callerName.
+func callerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+	if f := runtime.FuncForPC(pc); f != nil {
+		return f.Name()
+	}
+	return "unknown"
+}
+
 // Returns a properly initialized MutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyedMutex),
 	}
 }
@@ -4,6 +4,9 @@
 package conns
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -74,3 +77,51 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVLockContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "TestMutexKVLockContextCanceled") {
+		t.Errorf("expected error to name the lock holder, got %v", err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(t.Context(), "foo"); err != nil {
+		t.Fatalf("unexpected error after unlock: %s", err)
+	}
+}
+
+func TestMutexKVRemovesIdleKeys(t *testing.T) {
+	t.Parallel()
+
+	mkv := newMutexKV()
+
+	mkv.Lock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		mkv.Unlock("foo")
+		close(doneCh)
+	}()
+
+	mkv.Unlock("foo")
+	<-doneCh
+
+	if got, want := mkv.len(), 0; got != want {
+		t.Errorf("keys: got %d, want %d", got, want)
+	}
+}
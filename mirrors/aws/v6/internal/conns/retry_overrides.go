@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/errs"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+// RetryOverride declares how an AWS API error is retried, overriding the retry behavior of the service's API client.
+type RetryOverride struct {
+	// Operation is the AWS API operation name, e.g. "CreateFunction".
+	// If empty, the override applies to all of the service's operations.
+	Operation string
+	// ErrorCode is the AWS API error code, e.g. "LimitExceededException".
+	ErrorCode string
+	// ErrorMessage, if not empty, must be contained in the error message.
+	ErrorMessage string
+	// Retryable is whether the error is retried.
+	Retryable bool
+	// MaxRetries, if positive, caps the number of times a retryable error is retried.
+	// The client's maximum number of attempts still applies.
+	MaxRetries int
+}
+
+// ServicePackageWithRetryOverrides is an interface that extends ServicePackage with retry overrides.
+// The overrides apply to all AWS API clients created for the service package.
+// Overrides from provider configuration take precedence.
+type ServicePackageWithRetryOverrides interface {
+	ServicePackage
+	RetryOverrides(context.Context) []RetryOverride
+}
+
+func (o RetryOverride) validate() error {
+	if o.ErrorCode == "" {
+		return errors.New("empty error code")
+	}
+	if o.MaxRetries < 0 {
+		return fmt.Errorf("max retries must not be negative, got %d", o.MaxRetries)
+	}
+	if o.MaxRetries > 0 && !o.Retryable {
+		return errors.New("max retries requires retryable")
+	}
+	return nil
+}
+
+func (o RetryOverride) matches(operation string, err error) bool {
+	if o.Operation != "" && o.Operation != operation {
+		return false
+	}
+	return tfawserr.ErrMessageContains(err, o.ErrorCode, o.ErrorMessage)
+}
+
+// validateRetryOverrides validates retry overrides from provider configuration.
+// Overrides are keyed by service package name, e.g. "lambda".
+func validateRetryOverrides(overrides map[string][]RetryOverride) error {
+	for servicePackageName, overrides := range overrides {
+		if _, err := names.ProviderNameUpper(servicePackageName); err != nil {
+			return fmt.Errorf("retry override (%s): unknown service package", servicePackageName)
+		}
+		for _, override := range overrides {
+			if err := override.validate(); err != nil {
+				return fmt.Errorf("retry override (%s, %s): %w", servicePackageName, override.ErrorCode, err)
+			}
+		}
+	}
+	return nil
+}
+
+// effectiveRetryOverrides returns the retry overrides for the specified service in the order in which they are matched.
+// Overrides from provider configuration precede those declared by the service package and,
+// within each, operation-specific overrides precede service-wide ones.
+func (c *AWSClient) effectiveRetryOverrides(ctx context.Context, servicePackageName string) []RetryOverride {
+	byOperation := func(a, b RetryOverride) int {
+		switch {
+		case a.Operation != "" && b.Operation == "":
+			return -1
+		case a.Operation == "" && b.Operation != "":
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	overrides := slices.Clone(c.retryOverrides[servicePackageName])
+	slices.SortStableFunc(overrides, byOperation)
+
+	if v, ok := c.ServicePackage(ctx, servicePackageName).(ServicePackageWithRetryOverrides); ok {
+		spOverrides := slices.Clone(v.RetryOverrides(ctx))
+		slices.SortStableFunc(spOverrides, byOperation)
+		overrides = append(overrides, spOverrides...)
+	}
+
+	return overrides
+}
+
+// retryOverridesAPIOption returns an API option that matches errors against the specified retry overrides.
+func retryOverridesAPIOption(overrides []RetryOverride) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// The middleware must run once per attempt, i.e. inside the retry loop.
+		if _, ok := stack.Finalize.Get(retryMiddlewareID); !ok {
+			return nil
+		}
+		return stack.Finalize.Insert(&retryOverrider{overrides: overrides}, retryMiddlewareID, middleware.After)
+	}
+}
+
+// retryMiddlewareID is the ID of the AWS SDK for Go v2 retry middleware.
+const retryMiddlewareID = "Retry"
+
+// retryOverrider annotates an AWS API error with the first matching retry override.
+type retryOverrider struct {
+	overrides []RetryOverride
+}
+
+// ID is the middleware identifier.
+func (r *retryOverrider) ID() string {
+	return "PULUMI_AWS_RetryOverrider"
+}
+
+func (r *retryOverrider) HandleFinalize(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler,
+) (
+	out middleware.FinalizeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleFinalize(ctx, in)
+	if err == nil {
+		return out, metadata, err
+	}
+
+	operation := middleware.GetOperationName(ctx)
+	for _, override := range r.overrides {
+		if override.matches(operation, err) {
+			return out, metadata, &retryOverrideError{
+				err:      err,
+				override: override,
+			}
+		}
+	}
+
+	return out, metadata, err
+}
+
+// retryOverrideError is an AWS API error that matched a retry override.
+type retryOverrideError struct {
+	err      error
+	override RetryOverride
+}
+
+func (e *retryOverrideError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryOverrideError) Unwrap() error {
+	return e.err
+}
+
+// isErrorRetryableOverride returns whether the error matched a retry override and, if so, whether it is retryable.
+func isErrorRetryableOverride(err error) aws.Ternary {
+	if e, ok := errs.As[*retryOverrideError](err); ok {
+		return aws.BoolTernary(e.override.Retryable)
+	}
+	return aws.UnknownTernary
+}
+
+// retryDelayOverride returns an error if the error matched a retry override whose retries are exhausted.
+// attempt is the number of the attempt that failed, starting at 1.
+func retryDelayOverride(attempt int, err error) error {
+	if e, ok := errs.As[*retryOverrideError](err); ok && e.override.MaxRetries > 0 && attempt > e.override.MaxRetries {
+		return &retry.MaxAttemptsError{
+			Attempt: attempt,
+			Err:     err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/errs"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+func TestValidateRetryOverrides(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overrides   map[string][]RetryOverride
+		expectedErr bool
+	}{
+		"empty": {},
+		"valid": {
+			overrides: map[string][]RetryOverride{
+				names.Lambda: {
+					{ErrorCode: "KMSAccessDeniedException"},
+					{Operation: "UpdateFunctionCode", ErrorCode: "ResourceConflictException", Retryable: true, MaxRetries: 3},
+				},
+			},
+		},
+		"unknown service": {
+			overrides: map[string][]RetryOverride{
+				"nosuchservice": {{ErrorCode: "LimitExceededException"}},
+			},
+			expectedErr: true,
+		},
+		"empty error code": {
+			overrides: map[string][]RetryOverride{
+				names.Lambda: {{Retryable: true}},
+			},
+			expectedErr: true,
+		},
+		"negative max retries": {
+			overrides: map[string][]RetryOverride{
+				names.Lambda: {{ErrorCode: "ResourceConflictException", Retryable: true, MaxRetries: -1}},
+			},
+			expectedErr: true,
+		},
+		"max retries not retryable": {
+			overrides: map[string][]RetryOverride{
+				names.Lambda: {{ErrorCode: "ResourceConflictException", MaxRetries: 1}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRetryOverrides(testCase.overrides)
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Errorf("error: got %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestRetryOverrides(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxAttempts = 5
+		operation   = "CreateFunction"
+	)
+
+	testCases := map[string]struct {
+		overrides        []RetryOverride
+		errorCode        string
+		retryables       []retry.IsErrorRetryable
+		expectedAttempts int
+	}{
+		"no overrides": {
+			errorCode:        "ThrottlingException",
+			expectedAttempts: maxAttempts,
+		},
+		"non-retryable": {
+			overrides: []RetryOverride{
+				{ErrorCode: "ThrottlingException"},
+			},
+			errorCode:        "ThrottlingException",
+			expectedAttempts: 1,
+		},
+		"non-retryable message mismatch": {
+			overrides: []RetryOverride{
+				{ErrorCode: "ThrottlingException", ErrorMessage: "quota"},
+			},
+			errorCode:        "ThrottlingException",
+			expectedAttempts: maxAttempts,
+		},
+		"non-retryable other operation": {
+			overrides: []RetryOverride{
+				{Operation: "DeleteFunction", ErrorCode: "ThrottlingException"},
+			},
+			errorCode:        "ThrottlingException",
+			expectedAttempts: maxAttempts,
+		},
+		"retryable": {
+			overrides: []RetryOverride{
+				{ErrorCode: "ValidationException", Retryable: true},
+			},
+			errorCode:        "ValidationException",
+			expectedAttempts: maxAttempts,
+		},
+		"retryable capped": {
+			overrides: []RetryOverride{
+				{ErrorCode: "ValidationException", Retryable: true, MaxRetries: 2},
+			},
+			errorCode:        "ValidationException",
+			expectedAttempts: 3,
+		},
+		"first match wins": {
+			overrides: []RetryOverride{
+				{Operation: operation, ErrorCode: "ThrottlingException", Retryable: true, MaxRetries: 1},
+				{ErrorCode: "ThrottlingException"},
+			},
+			errorCode:        "ThrottlingException",
+			expectedAttempts: 2,
+		},
+		"precedes service package retryables": {
+			overrides: []RetryOverride{
+				{ErrorCode: "ThrottlingException"},
+			},
+			errorCode: "ThrottlingException",
+			retryables: []retry.IsErrorRetryable{
+				retry.IsErrorRetryableFunc(func(error) aws.Ternary {
+					return aws.TrueTernary
+				}),
+			},
+			expectedAttempts: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := middleware.WithOperationName(context.Background(), operation)
+
+			retryer := AddIsErrorRetryables(AddIsErrorRetryables(retry.NewStandard(func(o *retry.StandardOptions) {
+				o.MaxAttempts = maxAttempts
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+					return 0, nil
+				})
+				o.RateLimiter = ratelimit.None
+			})), testCase.retryables...)
+
+			stack := middleware.NewStack(operation, smithyhttp.NewStackRequest)
+			if err := stack.Finalize.Add(retry.NewAttemptMiddleware(retryer, smithyhttp.RequestCloner), middleware.After); err != nil {
+				t.Fatal(err)
+			}
+			if len(testCase.overrides) > 0 {
+				if err := retryOverridesAPIOption(testCase.overrides)(stack); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var attempts int
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+				attempts++
+				return nil, middleware.Metadata{}, &smithy.GenericAPIError{Code: testCase.errorCode, Message: "testing"}
+			}), stack)
+
+			_, _, err := handler.Handle(ctx, struct{}{})
+			if _, ok := errs.As[*smithy.GenericAPIError](err); !ok {
+				t.Errorf("error: got %v, want %s", err, testCase.errorCode)
+			}
+			if got, want := attempts, testCase.expectedAttempts; got != want {
+				t.Errorf("attempts: got %d, want %d", got, want)
+			}
+		})
+	}
+}
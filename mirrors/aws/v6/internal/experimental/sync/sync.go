@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"sync"
@@ -45,6 +46,21 @@ func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 	return semaphore
 }
 
+// NewSemaphore returns an unnamed semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
+// WaitContext waits for a semaphore before continuing, returning an error if the context is done first.
+func (s Semaphore) WaitContext(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Wait waits for a semaphore before continuing
 // NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
 func (s Semaphore) Wait() {
@@ -219,6 +219,8 @@ type ResourceDatum struct {
 	isARNFormatGlobal                 arnFormatState
 	wrappedImport                     common.TriBoolean
 	CustomImport                      bool
+	ImportState                       bool
+	ExistenceGuard                    string
 	goImports                         []common.GoImport
 	HasIdentityFix                    bool
 	common.ResourceIdentity
@@ -278,6 +280,9 @@ type visitor struct {
 	functionName string
 	packageName  string
 
+	// importStateTypes maps the names of the package's struct types to whether they implement `ImportState`.
+	importStateTypes map[string]bool
+
 	actions                map[string]ResourceDatum
 	ephemeralResources     map[string]ResourceDatum
 	frameworkDataSources   map[string]ResourceDatum
@@ -304,6 +309,7 @@ func (v *visitor) processDir(path string) {
 
 	for name, pkg := range packageMap {
 		v.packageName = name
+		v.importStateTypes = importStateTypes(pkg.Files)
 
 		for name, file := range pkg.Files {
 			v.fileName = name
@@ -314,9 +320,111 @@ func (v *visitor) processDir(path string) {
 		}
 
 		v.packageName = ""
+		v.importStateTypes = nil
 	}
 }
 
+// importStateTypes maps the names of the struct types declared in the specified files to whether they implement `ImportState`,
+// either directly or by embedding a type that does, e.g. `framework.WithImportByID`.
+func importStateTypes(files map[string]*ast.File) map[string]bool {
+	methods := make(map[string]bool)
+	embeds := make(map[string][]ast.Expr)
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil && len(decl.Recv.List) == 1 && decl.Name.Name == "ImportState" {
+					if ident, ok := baseTypeExpr(decl.Recv.List[0].Type).(*ast.Ident); ok {
+						methods[ident.Name] = true
+					}
+				}
+
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						if structType, ok := spec.Type.(*ast.StructType); ok {
+							embeds[spec.Name.Name] = []ast.Expr{}
+							for _, field := range structType.Fields.List {
+								if len(field.Names) == 0 {
+									embeds[spec.Name.Name] = append(embeds[spec.Name.Name], baseTypeExpr(field.Type))
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	result := make(map[string]bool)
+	var implements func(string, map[string]bool) bool
+	implements = func(name string, seen map[string]bool) bool {
+		if methods[name] {
+			return true
+		}
+		if seen[name] {
+			return false
+		}
+		seen[name] = true
+
+		for _, expr := range embeds[name] {
+			switch expr := expr.(type) {
+			case *ast.Ident:
+				if implements(expr.Name, seen) {
+					return true
+				}
+			case *ast.SelectorExpr:
+				if strings.HasPrefix(expr.Sel.Name, "WithImportBy") {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+	for name := range embeds {
+		result[name] = implements(name, make(map[string]bool))
+	}
+
+	return result
+}
+
+// baseTypeExpr strips any pointer and type arguments from a type expression.
+func baseTypeExpr(expr ast.Expr) ast.Expr {
+	for {
+		switch v := expr.(type) {
+		case *ast.StarExpr:
+			expr = v.X
+		case *ast.IndexExpr:
+			expr = v.X
+		case *ast.IndexListExpr:
+			expr = v.X
+		default:
+			return expr
+		}
+	}
+}
+
+// factoryImportState returns whether the type constructed by the specified factory function implements `ImportState`.
+// The constructed type is the first composite literal of a struct type declared in the package.
+func (v *visitor) factoryImportState(funcDecl *ast.FuncDecl) bool {
+	var result, found bool
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		if found {
+			return false
+		}
+		if lit, ok := node.(*ast.CompositeLit); ok && lit.Type != nil {
+			if ident, ok := baseTypeExpr(lit.Type).(*ast.Ident); ok {
+				result, found = v.importStateTypes[ident.Name]
+			}
+		}
+		return !found
+	})
+
+	return result
+}
+
 // processFile processes a single Go source file.
 func (v *visitor) processFile(file *ast.File) {
 	ast.Walk(v, file)
@@ -419,6 +527,13 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			case "CustomImport":
 				d.CustomImport = true
 
+			case "ExistenceGuard":
+				if len(args.Positional) != 1 {
+					v.errs = append(v.errs, fmt.Errorf("ExistenceGuard missing required parameter: at %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					d.ExistenceGuard = args.Positional[0]
+				}
+
 			case "ArnFormat":
 				if attr, ok := args.Keyword["global"]; ok {
 					if b, err := strconv.ParseBool(attr); err != nil {
@@ -570,6 +685,8 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					continue
 				}
 
+				d.ImportState = v.factoryImportState(funcDecl)
+
 				if _, ok := v.frameworkResources[typeName]; ok {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
@@ -678,7 +795,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity", "ExistenceGuard":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "NoImport", "Testing":
 				// Ignored.
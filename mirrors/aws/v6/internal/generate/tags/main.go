@@ -235,6 +235,12 @@ func main() {
 
 	awsPkg := service.GoPackageName()
 
+	// Stay under the service's per-call tag limit, unless overridden.
+	tagOpBatchSize := *tagOpBatchSize
+	if tagOpBatchSize == 0 {
+		tagOpBatchSize = service.TagsPerCallLimit()
+	}
+
 	createTagsFunc := *createTagsFunc
 	if *createTags && !*updateTags {
 		g.Infof("CreateTags only valid with UpdateTags")
@@ -283,7 +289,7 @@ func main() {
 		TagInTagsElem:              *tagInTagsElem,
 		TagKeyType:                 *tagKeyType,
 		TagOp:                      *tagOp,
-		TagOpBatchSize:             *tagOpBatchSize,
+		TagOpBatchSize:             tagOpBatchSize,
 		TagResTypeElem:             *tagResTypeElem,
 		TagResTypeElemType:         *tagResTypeElemType,
 		TagResTypeIsAccountID:      *tagResTypeIsAccountID,
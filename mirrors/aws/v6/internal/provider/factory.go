@@ -34,7 +34,9 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	}
 
 	servers := []func() tfprotov5.ProviderServer{
-		primary.GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return sdkv2.NewGRPCProviderServer(primary)
+		},
 		providerserver.NewProtocol5(secondary),
 	}
 
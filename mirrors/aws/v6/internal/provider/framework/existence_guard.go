@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
+)
+
+var _ resourceCRUDInterceptor = &existenceGuardInterceptor{}
+
+// existenceGuardInterceptor prevents a resource's Create from silently adopting an existing resource.
+// The lock on the resource's expected identifier is taken Before Create and released Finally,
+// so it must be the last interceptor run Before Create.
+type existenceGuardInterceptor struct {
+	typeName string
+	guard    inttypes.FrameworkExistenceGuard
+	held     sync.Map // *resource.CreateRequest -> lock key.
+}
+
+func (r *existenceGuardInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		id, err := r.guard.ExpectedID(ctx, request.Plan, opts.c)
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("determining %s identifier", r.typeName), err.Error())
+			return
+		}
+		if id == "" {
+			return
+		}
+
+		key := existenceGuardLockKey(ctx, opts.c, r.typeName, id)
+		if err := conns.GlobalMutexKV.LockContext(ctx, key); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating %s (%s)", r.typeName, id), err.Error())
+			return
+		}
+
+		err = r.guard.Find(ctx, id, opts.c)
+
+		if err == nil {
+			conns.GlobalMutexKV.Unlock(key)
+			response.Diagnostics.AddError(
+				fmt.Sprintf("%s (%s) already exists", r.typeName, id),
+				"To manage the existing resource, import it into state.",
+			)
+			return
+		}
+		if !retry.NotFound(err) {
+			conns.GlobalMutexKV.Unlock(key)
+			response.Diagnostics.AddError(fmt.Sprintf("checking for existing %s (%s)", r.typeName, id), err.Error())
+			return
+		}
+
+		r.held.Store(request, key)
+	case Finally:
+		if key, ok := r.held.LoadAndDelete(request); ok {
+			conns.GlobalMutexKV.Unlock(key.(string))
+		}
+	}
+}
+
+func (r *existenceGuardInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) {
+}
+
+func (r *existenceGuardInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) {
+}
+
+func (r *existenceGuardInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+}
+
+// existenceGuardLockKey returns the key used to serialize creation of resources with the same identifier.
+func existenceGuardLockKey(ctx context.Context, c awsClient, typeName, id string) string {
+	return fmt.Sprintf("existence-guard/%s/%s/%s/%s", typeName, c.AccountID(ctx), c.Region(ctx), id)
+}
+
+func newExistenceGuardInterceptor(typeName string, guard inttypes.FrameworkExistenceGuard) *existenceGuardInterceptor {
+	return &existenceGuardInterceptor{
+		typeName: typeName,
+		guard:    guard,
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+)
+
+type mockExistenceGuard struct {
+	findErr error
+}
+
+func (g mockExistenceGuard) ExpectedID(ctx context.Context, plan tfsdk.Plan, _ any) (string, error) {
+	var name types.String
+	if diags := plan.GetAttribute(ctx, path.Root("name"), &name); diags.HasError() {
+		return "", errors.New("reading name")
+	}
+
+	return name.ValueString(), nil
+}
+
+func (g mockExistenceGuard) Find(context.Context, string, any) error {
+	return g.findErr
+}
+
+func TestExistenceGuardInterceptor(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	client := mockClient{
+		accountID: "123456789012",
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := map[string]struct {
+		name          string
+		findErr       error
+		expectedError bool
+	}{
+		"exists": {
+			name:          "exists",
+			expectedError: true,
+		},
+		"not found": {
+			name:    "not-found",
+			findErr: &retry.NotFoundError{},
+		},
+		"find error": {
+			name:          "find-error",
+			findErr:       errors.New("boom"),
+			expectedError: true,
+		},
+		"unknown identifier": {},
+	}
+
+	for tname, tc := range testCases {
+		t.Run(tname, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			interceptor := newExistenceGuardInterceptor("aws_test", mockExistenceGuard{findErr: tc.findErr})
+
+			values := map[string]string{}
+			if tc.name != "" {
+				values["name"] = tc.name
+			}
+			request := resource.CreateRequest{
+				Plan: planFromSchema(ctx, resourceSchema, values),
+			}
+			response := resource.CreateResponse{}
+
+			interceptor.create(ctx, interceptorOptions[resource.CreateRequest, resource.CreateResponse]{
+				c:        client,
+				request:  &request,
+				response: &response,
+				when:     Before,
+			})
+			if got, want := response.Diagnostics.HasError(), tc.expectedError; got != want {
+				t.Errorf("HasError: got %t, want %t (%v)", got, want, response.Diagnostics)
+			}
+
+			interceptor.create(ctx, interceptorOptions[resource.CreateRequest, resource.CreateResponse]{
+				c:        client,
+				request:  &request,
+				response: &response,
+				when:     Finally,
+			})
+
+			// The lock must have been released.
+			if tc.name != "" {
+				key := existenceGuardLockKey(ctx, client, "aws_test", tc.name)
+				ctx, cancel := context.WithTimeout(ctx, time.Second)
+				defer cancel()
+				if err := conns.GlobalMutexKV.LockContext(ctx, key); err != nil {
+					t.Fatal(err)
+				}
+				conns.GlobalMutexKV.Unlock(key)
+			}
+		})
+	}
+}
@@ -402,6 +402,10 @@ func (c mockClient) ServicePackage(_ context.Context, name string) conns.Service
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) TagDriftDiagnostics(ctx context.Context) bool {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
 	panic("not implemented") //lintignore:R009
 }
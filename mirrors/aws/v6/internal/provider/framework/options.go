@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/initreport"
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+)
+
+// ProviderOption represents a NewProvider option.
+type ProviderOption func(*providerOptions)
+
+type providerOptions struct {
+	report          *initreport.Report
+	strictness      initreport.Strictness
+	tagsAllMode     tftags.TagsAllMode
+	validateSchemas bool
+}
+
+// WithTagsAllMode sets how resources' `tags_all` attributes are managed.
+// If not specified, `tags_all` is Computed by the provider.
+func WithTagsAllMode(mode tftags.TagsAllMode) ProviderOption {
+	return func(o *providerOptions) {
+		o.tagsAllMode = mode
+	}
+}
+
+// WithInitializationReport records the problems found while initializing the provider in report,
+// failing initialization only on problems that are fatal with the specified strictness.
+func WithInitializationReport(report *initreport.Report, strictness initreport.Strictness) ProviderOption {
+	return func(o *providerOptions) {
+		o.report = report
+		o.strictness = strictness
+	}
+}
+
+// WithSchemaValidation validates resource, data source etc. schemas when the provider is created.
+// This materializes every schema, so it's intended for tests.
+func WithSchemaValidation() ProviderOption {
+	return func(o *providerOptions) {
+		o.validateSchemas = true
+	}
+}
+
+func newProviderOptions(opts ...ProviderOption) providerOptions {
+	var o providerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -5,7 +5,6 @@ package framework
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"iter"
 	"log"
@@ -19,6 +18,7 @@ import (
 	aschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	empemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -34,16 +34,13 @@ import (
 	"github.com/blampe/patches/mirrors/aws/v6/internal/framework"
 	fwtypes "github.com/blampe/patches/mirrors/aws/v6/internal/framework/types"
 	tffunction "github.com/blampe/patches/mirrors/aws/v6/internal/function"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/initreport"
 	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
 	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
 	tfunique "github.com/blampe/patches/mirrors/aws/v6/internal/unique"
 	"github.com/blampe/patches/mirrors/aws/v6/names"
 )
 
-var (
-	resourceSchemasValidated sync.Once
-)
-
 var (
 	_ provider.Provider                       = &frameworkProvider{}
 	_ provider.ProviderWithActions            = &frameworkProvider{}
@@ -58,6 +55,7 @@ type frameworkProvider struct {
 	dataSources        []func() datasource.DataSource
 	ephemeralResources []func() ephemeral.EphemeralResource
 	listResources      []func() list.ListResource
+	options            providerOptions
 	primary            interface{ Meta() any }
 	resources          []func() resource.Resource
 	servicePackages    iter.Seq[conns.ServicePackage]
@@ -65,26 +63,30 @@ type frameworkProvider struct {
 
 // NewProvider returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
-func NewProvider(ctx context.Context, primary interface{ Meta() any }) (provider.Provider, error) {
+func NewProvider(ctx context.Context, primary interface{ Meta() any }, opts ...ProviderOption) (provider.Provider, error) {
 	log.Printf("Creating Terraform AWS Provider (Framework-style)...")
 
 	provider := &frameworkProvider{
 		actions:            make([]func() action.Action, 0),
 		dataSources:        make([]func() datasource.DataSource, 0),
 		ephemeralResources: make([]func() ephemeral.EphemeralResource, 0),
+		options:            newProviderOptions(opts...),
 		primary:            primary,
 		resources:          make([]func() resource.Resource, 0),
 		servicePackages:    primary.Meta().(*conns.AWSClient).ServicePackages(ctx),
 	}
 
-	// Because we try and share resource schemas as much as possible,
-	// we need to ensure that we only validate the resource schemas once.
-	var err error
-	resourceSchemasValidated.Do(func() {
-		err = provider.validateResourceSchemas(ctx)
-	})
-	if err != nil {
-		return nil, err
+	// Validating resource schemas materializes every schema, so is only done on request, e.g. in tests.
+	// Otherwise each schema is validated on first use.
+	if provider.options.validateSchemas {
+		resourceSchemasReport := provider.validateResourceSchemas(ctx)
+
+		if v := provider.options.report; v != nil {
+			v.Append(resourceSchemasReport)
+		}
+		if err := resourceSchemasReport.Err(provider.options.strictness); err != nil {
+			return nil, err
+		}
 	}
 
 	provider.initialize(ctx)
@@ -109,6 +111,15 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"bulk_tag_refresh": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Read resource tags during refresh in batches using the Resource Groups Tagging API instead of one call to the resource's service API per resource. Requires the `tag:GetResources` IAM permission.",
+			},
+			"concurrency_limits": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "Limits the number of concurrent AWS API calls. Keys are service package names, e.g. `route53`, or service package and API operation names separated by a colon, e.g. `route53:ChangeResourceRecordSets`. Values are the maximum number of concurrent calls.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -201,6 +212,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"tag_drift_diagnostics": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to emit a warning during refresh listing the tag keys of a resource added, removed or changed outside of Terraform.",
+			},
 			"tag_policy_compliance": schema.StringAttribute{
 				Optional: true,
 				Description: `The severity with which to enforce organizational tagging policies on resources managed by this provider instance. ` +
@@ -209,6 +224,13 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 					`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 			},
+			"tag_policy_document": schema.StringAttribute{
+				Optional: true,
+				Description: `An AWS Organizations tag policy document, either its JSON content or the path to a file containing it, ` +
+					`with which to check tag policy compliance instead of the account's effective tag policy. ` +
+					`Has no effect unless tag_policy_compliance is enabled. ` +
+					`Can also be configured with the ` + tftags.TagPolicyDocumentEnvVar + ` environment variable.`,
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -322,6 +344,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"propagate_at_launch": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether default tags applied to Auto Scaling Groups are propagated to the EC2 instances they launch.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -329,6 +355,27 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"scope": schema.ListNestedBlock{
+							Description: "Configuration blocks with settings to default resource tags on selected resources only.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"exclude_keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Keys of default resource tags not to apply to the selected resources.",
+									},
+									"resource_types":   tagScopeResourceTypesAttribute(),
+									"service_packages": tagScopeServicePackagesAttribute(),
+									"tags": schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tags to default across the selected resources.",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			"endpoints": endpointsBlock(),
@@ -352,12 +399,80 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 								"Can also be configured with the " + tftags.IgnoreTagsKeysEnvVar + " environment variable.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"scope": schema.ListNestedBlock{
+							Description: "Configuration blocks with settings to ignore resource tags on selected resources only.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_prefixes": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag key prefixes to ignore across the selected resources.",
+									},
+									"keys": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Resource tag keys to ignore across the selected resources.",
+									},
+									"resource_types":   tagScopeResourceTypesAttribute(),
+									"service_packages": tagScopeServicePackagesAttribute(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"retry_overrides": schema.ListNestedBlock{
+				Description: "Configuration blocks that override how AWS API errors are retried.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_code": schema.StringAttribute{
+							Required:    true,
+							Description: "AWS API error code, e.g. `LimitExceededException`.",
+						},
+						"error_message": schema.StringAttribute{
+							Optional:    true,
+							Description: "Text that the error message must contain.",
+						},
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum number of times a retryable error is retried. Requires `retryable`.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "AWS API operation name, e.g. `CreateFunction`. If not set, the override applies to all of the service's operations.",
+						},
+						"retryable": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether the error is retried.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service package name, e.g. `lambda`.",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
+func tagScopeResourceTypesAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Terraform resource types to select, in which `*` matches any sequence of characters, e.g. `aws_iam_*`.",
+	}
+}
+
+func tagScopeServicePackagesAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Service packages whose resources to select, e.g. `ec2`.",
+	}
+}
+
 func (p *frameworkProvider) MetaSchema(ctx context.Context, req provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
 	resp.Schema = metaschema.Schema{
 		Attributes: map[string]metaschema.Attribute{
@@ -441,15 +556,21 @@ func (p *frameworkProvider) initialize(ctx context.Context) {
 		servicePackageName := sp.ServicePackageName()
 
 		for _, dataSourceSpec := range sp.FrameworkDataSources(ctx) {
+			validateSchema := p.lazySchemaValidation(initreport.KindDataSource, dataSourceSpec.TypeName, func(ctx context.Context) (initreport.Problem, error) {
+				return validateDataSourceSchema(ctx, dataSourceSpec)
+			})
 			p.dataSources = append(p.dataSources, func() datasource.DataSource { //nolint:contextcheck // must be a func()
-				return newWrappedDataSource(dataSourceSpec, servicePackageName)
+				return newWrappedDataSource(dataSourceSpec, servicePackageName, validateSchema)
 			})
 		}
 
 		if v, ok := sp.(conns.ServicePackageWithEphemeralResources); ok {
 			for _, ephemeralResourceSpec := range v.EphemeralResources(ctx) {
+				validateSchema := p.lazySchemaValidation(initreport.KindEphemeralResource, ephemeralResourceSpec.TypeName, func(ctx context.Context) (initreport.Problem, error) {
+					return validateEphemeralResourceSchema(ctx, ephemeralResourceSpec)
+				})
 				p.ephemeralResources = append(p.ephemeralResources, func() ephemeral.EphemeralResource { //nolint:contextcheck // must be a func()
-					return newWrappedEphemeralResource(ephemeralResourceSpec, servicePackageName)
+					return newWrappedEphemeralResource(ephemeralResourceSpec, servicePackageName, validateSchema)
 				})
 			}
 		}
@@ -470,128 +591,192 @@ func (p *frameworkProvider) initialize(ctx context.Context) {
 		}
 
 		for _, resourceSpec := range sp.FrameworkResources(ctx) {
+			validateSchema := p.lazySchemaValidation(initreport.KindResource, resourceSpec.TypeName, func(ctx context.Context) (initreport.Problem, error) {
+				return p.validateResourceSchema(ctx, resourceSpec)
+			})
 			p.resources = append(p.resources, func() resource.Resource { //nolint:contextcheck // must be a func()
-				return newWrappedResource(resourceSpec, servicePackageName)
+				return newWrappedResource(resourceSpec, servicePackageName, p.options.tagsAllMode, validateSchema)
 			})
 		}
 
 		if v, ok := sp.(conns.ServicePackageWithActions); ok {
 			for _, actionSpec := range v.Actions(ctx) {
+				validateSchema := p.lazySchemaValidation(initreport.KindAction, actionSpec.TypeName, func(ctx context.Context) (initreport.Problem, error) {
+					return validateActionSchema(ctx, actionSpec)
+				})
 				p.actions = append(p.actions, func() action.Action { //nolint:contextcheck // must be a func()
-					return newWrappedAction(actionSpec, servicePackageName)
+					return newWrappedAction(actionSpec, servicePackageName, validateSchema)
 				})
 			}
 		}
 	}
 }
 
-// validateResourceSchemas is called from `New` to validate Terraform Plugin Framework-style resource schemas.
-func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) error {
-	var errs []error
+// validateResourceSchemas is called from `New` to validate every Terraform Plugin Framework-style resource schema.
+func (p *frameworkProvider) validateResourceSchemas(ctx context.Context) *initreport.Report {
+	var report initreport.Report
 
 	for sp := range p.servicePackages {
 		for _, dataSourceSpec := range sp.FrameworkDataSources(ctx) {
-			typeName := dataSourceSpec.TypeName
-			inner, err := dataSourceSpec.Factory(ctx)
-
-			if err != nil {
-				errs = append(errs, fmt.Errorf("creating data source type (%s): %w", typeName, err))
-				continue
-			}
-
-			schemaResponse := datasource.SchemaResponse{}
-			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
-
-			if err := validateSchemaRegionForDataSource(dataSourceSpec.Region, schemaResponse.Schema); err != nil {
-				errs = append(errs, fmt.Errorf("data source type %q: %w", typeName, err))
-				continue
-			}
-
-			if err := validateSchemaTagsForDataSource(dataSourceSpec.Tags, schemaResponse.Schema); err != nil {
-				errs = append(errs, fmt.Errorf("data source type %q: %w", typeName, err))
-				continue
+			if problem, err := validateDataSourceSchema(ctx, dataSourceSpec); err != nil {
+				report.Add(initreport.KindDataSource, dataSourceSpec.TypeName, problem, err)
 			}
 		}
 
 		if v, ok := sp.(conns.ServicePackageWithEphemeralResources); ok {
 			for _, ephemeralResourceSpec := range v.EphemeralResources(ctx) {
-				typeName := ephemeralResourceSpec.TypeName
-				inner, err := ephemeralResourceSpec.Factory(ctx)
-
-				if err != nil {
-					errs = append(errs, fmt.Errorf("creating ephemeral resource type (%s): %w", typeName, err))
-					continue
-				}
-
-				schemaResponse := ephemeral.SchemaResponse{}
-				inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
-
-				if err := validateSchemaRegionForEphemeralResource(ephemeralResourceSpec.Region, schemaResponse.Schema); err != nil {
-					errs = append(errs, fmt.Errorf("ephemeral resource type %q: %w", typeName, err))
-					continue
+				if problem, err := validateEphemeralResourceSchema(ctx, ephemeralResourceSpec); err != nil {
+					report.Add(initreport.KindEphemeralResource, ephemeralResourceSpec.TypeName, problem, err)
 				}
 			}
 		}
 
 		if v, ok := sp.(conns.ServicePackageWithActions); ok {
 			for _, actionSpec := range v.Actions(ctx) {
-				typeName := actionSpec.TypeName
-				inner, err := actionSpec.Factory(ctx)
-
-				if err != nil {
-					errs = append(errs, fmt.Errorf("creating action type (%s): %w", typeName, err))
-					continue
-				}
-
-				schemaResponse := action.SchemaResponse{}
-				inner.Schema(ctx, action.SchemaRequest{}, &schemaResponse)
-
-				if err := validateSchemaRegionForAction(actionSpec.Region, schemaResponse.Schema); err != nil {
-					errs = append(errs, fmt.Errorf("action type %q: %w", typeName, err))
-					continue
+				if problem, err := validateActionSchema(ctx, actionSpec); err != nil {
+					report.Add(initreport.KindAction, actionSpec.TypeName, problem, err)
 				}
 			}
 		}
 
 		for _, resourceSpec := range sp.FrameworkResources(ctx) {
-			typeName := resourceSpec.TypeName
-			inner, err := resourceSpec.Factory(ctx)
-
-			if err != nil {
-				errs = append(errs, fmt.Errorf("creating resource type (%s): %w", typeName, err))
-				continue
+			if problem, err := p.validateResourceSchema(ctx, resourceSpec); err != nil {
+				report.Add(initreport.KindResource, resourceSpec.TypeName, problem, err)
 			}
+		}
+	}
+
+	return &report
+}
 
-			schemaResponse := resource.SchemaResponse{}
-			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+// lazySchemaValidation returns a function that validates a schema on its first call, returning any problem as diagnostics.
+// Schemas already validated when the provider was created aren't validated again.
+func (p *frameworkProvider) lazySchemaValidation(kind initreport.Kind, typeName string, validate func(context.Context) (initreport.Problem, error)) func(context.Context) diag.Diagnostics {
+	if p.options.validateSchemas {
+		return func(context.Context) diag.Diagnostics {
+			return nil
+		}
+	}
 
-			if err := validateSchemaRegionForResource(resourceSpec.Region, schemaResponse.Schema); err != nil {
-				errs = append(errs, fmt.Errorf("resource type %q: %w", typeName, err))
-				continue
+	var once sync.Once
+	var diags diag.Diagnostics
+
+	return func(ctx context.Context) diag.Diagnostics {
+		once.Do(func() {
+			problem, err := validate(ctx)
+			if err == nil {
+				return
 			}
 
-			if err := validateSchemaTagsForResource(resourceSpec.Tags, schemaResponse.Schema); err != nil {
-				errs = append(errs, fmt.Errorf("resource type %q: %w", typeName, err))
-				continue
+			var report initreport.Report
+			report.Add(kind, typeName, problem, err)
+			if report.Err(p.options.strictness) != nil {
+				diags.AddError("Invalid schema", report.Error())
+			} else {
+				diags.AddWarning("Invalid schema", report.Error())
 			}
+		})
 
-			if resourceSpec.Import.WrappedImport {
-				if resourceSpec.Import.SetIDAttr {
-					if _, ok := resourceSpec.Import.ImportID.(inttypes.FrameworkImportIDCreator); !ok {
-						errs = append(errs, fmt.Errorf("resource type %q: importer sets `%s` attribute, but creator isn't configured", resourceSpec.TypeName, names.AttrID))
-						continue
-					}
-				}
+		return diags
+	}
+}
 
-				if _, ok := inner.(framework.ImportByIdentityer); !ok {
-					errs = append(errs, fmt.Errorf("resource type %q: cannot configure importer, does not implement %q", resourceSpec.TypeName, reflect.TypeFor[framework.ImportByIdentityer]()))
-					continue
-				}
+func validateDataSourceSchema(ctx context.Context, spec *inttypes.ServicePackageFrameworkDataSource) (initreport.Problem, error) {
+	inner, err := spec.Factory(ctx)
+
+	if err != nil {
+		return initreport.ProblemFactory, err
+	}
+
+	schemaResponse := datasource.SchemaResponse{}
+	inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+	if err := validateSchemaRegionForDataSource(spec.Region, schemaResponse.Schema); err != nil {
+		return initreport.ProblemRegionAttribute, err
+	}
+
+	if err := validateSchemaTagsForDataSource(spec.Tags, schemaResponse.Schema); err != nil {
+		return initreport.ProblemTagsAttribute, err
+	}
+
+	return "", nil
+}
+
+func validateEphemeralResourceSchema(ctx context.Context, spec *inttypes.ServicePackageEphemeralResource) (initreport.Problem, error) {
+	inner, err := spec.Factory(ctx)
+
+	if err != nil {
+		return initreport.ProblemFactory, err
+	}
+
+	schemaResponse := ephemeral.SchemaResponse{}
+	inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+
+	if err := validateSchemaRegionForEphemeralResource(spec.Region, schemaResponse.Schema); err != nil {
+		return initreport.ProblemRegionAttribute, err
+	}
+
+	return "", nil
+}
+
+func validateActionSchema(ctx context.Context, spec *inttypes.ServicePackageAction) (initreport.Problem, error) {
+	inner, err := spec.Factory(ctx)
+
+	if err != nil {
+		return initreport.ProblemFactory, err
+	}
+
+	schemaResponse := action.SchemaResponse{}
+	inner.Schema(ctx, action.SchemaRequest{}, &schemaResponse)
+
+	if err := validateSchemaRegionForAction(spec.Region, schemaResponse.Schema); err != nil {
+		return initreport.ProblemRegionAttribute, err
+	}
+
+	return "", nil
+}
+
+func (p *frameworkProvider) validateResourceSchema(ctx context.Context, spec *inttypes.ServicePackageFrameworkResource) (initreport.Problem, error) {
+	inner, err := spec.Factory(ctx)
+
+	if err != nil {
+		return initreport.ProblemFactory, err
+	}
+
+	schemaResponse := resource.SchemaResponse{}
+	inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+	if err := validateSchemaRegionForResource(spec.Region, schemaResponse.Schema); err != nil {
+		return initreport.ProblemRegionAttribute, err
+	}
+
+	if err := validateSchemaTagsForResource(spec.Tags, schemaResponse.Schema); err != nil {
+		return initreport.ProblemTagsAttribute, err
+	}
+
+	if p.options.tagsAllMode == tftags.TagsAllCallerManaged {
+		if err := validateSchemaTagsAllCallerManaged(schemaResponse.Schema); err != nil {
+			return initreport.ProblemTagsAttribute, err
+		}
+	}
+
+	if _, ok := inner.(resource.ResourceWithImportState); ok != spec.Import.ImportState {
+		return initreport.ProblemImport, fmt.Errorf("registered as implementing ImportState (%t), but resource implementation doesn't match; regenerate the service package", spec.Import.ImportState)
+	}
+
+	if spec.Import.WrappedImport {
+		if spec.Import.SetIDAttr {
+			if _, ok := spec.Import.ImportID.(inttypes.FrameworkImportIDCreator); !ok {
+				return initreport.ProblemImport, fmt.Errorf("importer sets `%s` attribute, but creator isn't configured", names.AttrID)
 			}
 		}
+
+		if _, ok := inner.(framework.ImportByIdentityer); !ok {
+			return initreport.ProblemImport, fmt.Errorf("cannot configure importer, does not implement %q", reflect.TypeFor[framework.ImportByIdentityer]())
+		}
 	}
 
-	return errors.Join(errs...)
+	return "", nil
 }
 
 func validateSchemaRegionForDataSource(regionSpec unique.Handle[inttypes.ServicePackageResourceRegion], schema datasourceschema.Schema) error {
@@ -664,3 +849,12 @@ func validateSchemaTagsForResource(tagsSpec unique.Handle[inttypes.ServicePackag
 	}
 	return nil
 }
+
+func validateSchemaTagsAllCallerManaged(schema resourceschema.Schema) error {
+	if _, ok := schema.Attributes[names.AttrTagsAll]; ok {
+		if _, ok := schema.Attributes[names.AttrTags]; !ok {
+			return fmt.Errorf("`%s` attribute defined in schema without `%s`", names.AttrTagsAll, names.AttrTags)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/initreport"
+	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
+)
+
+type mockDataSource struct{}
+
+func (mockDataSource) Metadata(context.Context, datasource.MetadataRequest, *datasource.MetadataResponse) {
+}
+
+func (mockDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{}
+}
+
+func (mockDataSource) Read(context.Context, datasource.ReadRequest, *datasource.ReadResponse) {
+}
+
+func (mockDataSource) Configure(context.Context, datasource.ConfigureRequest, *datasource.ConfigureResponse) {
+}
+
+func TestWrappedDataSourceDefersFactory(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	var factoryCalls, validateCalls int
+	spec := &inttypes.ServicePackageFrameworkDataSource{
+		Factory: func(context.Context) (datasource.DataSourceWithConfigure, error) {
+			factoryCalls++
+			return mockDataSource{}, nil
+		},
+		TypeName: "aws_test",
+	}
+	validateSchema := func(context.Context) diag.Diagnostics {
+		validateCalls++
+		return nil
+	}
+
+	w := newWrappedDataSource(spec, "test", validateSchema)
+	w.Metadata(ctx, datasource.MetadataRequest{}, &datasource.MetadataResponse{})
+
+	if got, want := factoryCalls, 0; got != want {
+		t.Errorf("Factory calls after Metadata: got %d, want %d", got, want)
+	}
+
+	for range 2 {
+		w.Schema(ctx, datasource.SchemaRequest{}, &datasource.SchemaResponse{})
+	}
+
+	if got, want := factoryCalls, 1; got != want {
+		t.Errorf("Factory calls after Schema: got %d, want %d", got, want)
+	}
+	if got, want := validateCalls, 2; got != want {
+		t.Errorf("validation calls: got %d, want %d", got, want)
+	}
+}
+
+func TestLazySchemaValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options      providerOptions
+		problem      initreport.Problem
+		err          error
+		wantCalls    int
+		wantError    bool
+		wantWarnings int
+	}{
+		"valid": {
+			wantCalls: 1,
+		},
+		"strict warning": {
+			problem:   initreport.ProblemTagsAttribute,
+			err:       errors.New("bad tags"),
+			wantCalls: 1,
+			wantError: true,
+		},
+		"lenient warning": {
+			options: providerOptions{
+				strictness: initreport.Lenient,
+			},
+			problem:      initreport.ProblemTagsAttribute,
+			err:          errors.New("bad tags"),
+			wantCalls:    1,
+			wantWarnings: 1,
+		},
+		"lenient error": {
+			options: providerOptions{
+				strictness: initreport.Lenient,
+			},
+			problem:   initreport.ProblemImport,
+			err:       errors.New("bad import"),
+			wantCalls: 1,
+			wantError: true,
+		},
+		"validated on creation": {
+			options: providerOptions{
+				validateSchemas: true,
+			},
+			problem: initreport.ProblemImport,
+			err:     errors.New("bad import"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			p := &frameworkProvider{
+				options: tc.options,
+			}
+			var calls int
+			validateSchema := p.lazySchemaValidation(initreport.KindDataSource, "aws_test", func(context.Context) (initreport.Problem, error) {
+				calls++
+				return tc.problem, tc.err
+			})
+
+			var diags diag.Diagnostics
+			for range 2 {
+				diags = validateSchema(ctx)
+			}
+
+			if got, want := calls, tc.wantCalls; got != want {
+				t.Errorf("calls: got %d, want %d", got, want)
+			}
+			if got, want := diags.HasError(), tc.wantError; got != want {
+				t.Errorf("HasError: got %t, want %t", got, want)
+			}
+			if got, want := diags.WarningsCount(), tc.wantWarnings; got != want {
+				t.Errorf("warnings: got %d, want %d", got, want)
+			}
+		})
+	}
+}
@@ -78,6 +78,7 @@ func dataSourceTransparentTagging(servicePackageResourceTags unique.Handle[intty
 type tagsResourceInterceptor struct {
 	resourceNoOpCRUDInterceptor
 	interceptors.HTags
+	tagsAllMode tftags.TagsAllMode
 }
 
 func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
@@ -106,6 +107,11 @@ func (r tagsResourceInterceptor) create(ctx context.Context, opts interceptorOpt
 		tags = tags.IgnoreSystem(sp.ServicePackageName())
 		tagsInContext.TagsIn = option.Some(tags)
 	case After:
+		// Tags read in bulk during a later refresh must not be stale.
+		if identifier := r.GetIdentifierFramework(ctx, response.State); identifier != "" {
+			r.InvalidateBulkTags(ctx, c, identifier)
+		}
+
 		// Set values for unknowns.
 		// Remove any provider configured ignore_tags and system tags from those passed to the service API.
 		// Computed tags_all include any provider configured default_tags.
@@ -124,7 +130,7 @@ func (r tagsResourceInterceptor) read(ctx context.Context, opts interceptorOptio
 		return
 	}
 
-	sp, serviceName, resourceName, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	sp, serviceName, resourceName, typeName, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
 	if !ok {
 		return
 	}
@@ -141,7 +147,17 @@ func (r tagsResourceInterceptor) read(ctx context.Context, opts interceptorOptio
 			// Some old resources may not have the required attribute set after Read:
 			// https://github.com/blampe/patches/mirrors/aws/v6/issues/31180
 			if identifier := r.GetIdentifierFramework(ctx, response.State); identifier != "" {
-				if err := r.ListTags(ctx, sp, c, identifier); err != nil {
+				var priorTagsAll tftags.Map
+				opts.request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &priorTagsAll)
+
+				var err error
+				if !priorTagsAll.IsNull() {
+					// Tags may be read in bulk during a refresh, but not on import.
+					err = r.RefreshTags(ctx, sp, c, typeName, identifier)
+				} else {
+					err = r.ListTags(ctx, sp, c, identifier)
+				}
+				if err != nil {
 					opts.response.Diagnostics.AddError(fmt.Sprintf("listing tags for %s %s (%s)", serviceName, resourceName, identifier), err.Error())
 
 					return
@@ -164,6 +180,17 @@ func (r tagsResourceInterceptor) read(ctx context.Context, opts interceptorOptio
 			return
 		}
 
+		// Report tags changed outside of Terraform since the prior state, e.g. not on import.
+		if c.TagDriftDiagnostics(ctx) {
+			var priorTagsAll tftags.Map
+			opts.request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &priorTagsAll)
+			if !priorTagsAll.IsNull() && !priorTagsAll.IsUnknown() {
+				if summary, detail, ok := interceptors.TagDrift(typeName, tftags.New(ctx, priorTagsAll), apiTags.IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx))); ok {
+					opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTagsAll), summary, detail)
+				}
+			}
+		}
+
 		// Computed tags_all do.
 		stateTagsAll := fwflex.FlattenFrameworkStringValueMapLegacy(ctx, apiTags.IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx)).Map())
 		opts.response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.NewMapFromMapValue(stateTagsAll))...)
@@ -221,6 +248,11 @@ func (r tagsResourceInterceptor) update(ctx context.Context, opts interceptorOpt
 			}
 			// TODO If the only change was to tags it would be nice to not call the resource's U handler.
 		}
+	case After:
+		// Tags read in bulk during a later refresh must not be stale.
+		if identifier := r.GetIdentifierFramework(ctx, opts.response.State); identifier != "" {
+			r.InvalidateBulkTags(ctx, c, identifier)
+		}
 	}
 }
 
@@ -243,8 +275,22 @@ func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, opts intercepto
 
 		if planTags.IsWhollyKnown() {
 			allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(c.IgnoreTagsConfig(ctx))
-			opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
-		} else {
+			if sp, _, _, _, _, ok := interceptors.InfoFromContext(ctx, c); ok {
+				if summary, detail, ok := interceptors.CaseInsensitiveDuplicateTags(sp.ServicePackageName(), c.DefaultTagsConfig(ctx), tftags.New(ctx, planTags)); ok {
+					opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+					return
+				}
+				if summary, detail, ok := interceptors.TagLimitExceeded(sp.ServicePackageName(), allTags); ok {
+					opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+					return
+				}
+			}
+
+			// Callers that manage `tags_all` themselves plan its value.
+			if r.tagsAllMode != tftags.TagsAllCallerManaged {
+				opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+			}
+		} else if r.tagsAllMode != tftags.TagsAllCallerManaged {
 			opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
 
@@ -254,15 +300,41 @@ func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, opts intercepto
 	}
 }
 
-func resourceTransparentTagging(servicePackageResourceTags unique.Handle[inttypes.ServicePackageResourceTags]) interface {
+func resourceTransparentTagging(servicePackageResourceTags unique.Handle[inttypes.ServicePackageResourceTags], tagsAllMode tftags.TagsAllMode) interface {
 	resourceCRUDInterceptor
 	resourceModifyPlanInterceptor
 } {
 	return &tagsResourceInterceptor{
-		HTags: interceptors.HTags(servicePackageResourceTags),
+		HTags:       interceptors.HTags(servicePackageResourceTags),
+		tagsAllMode: tagsAllMode,
 	}
 }
 
+type resourceTagsAllFromTagsInterceptor struct{}
+
+func (r resourceTagsAllFromTagsInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Attributes[names.AttrTagsAll]; !ok {
+			return
+		}
+
+		v, ok := response.Schema.Attributes[names.AttrTags]
+		if !ok {
+			opts.response.Diagnostics.AddError("invalid resource schema", fmt.Sprintf("`%s` attribute defined without `%s`", names.AttrTagsAll, names.AttrTags))
+			return
+		}
+
+		response.Schema.Attributes[names.AttrTagsAll] = v
+	}
+}
+
+// resourceTagsAllFromTags rewrites a resource's `tags_all` schema to match its `tags` schema.
+// Used when the caller copies `tags` into `tags_all` before the provider sees the configuration.
+func resourceTagsAllFromTags() resourceSchemaInterceptor {
+	return &resourceTagsAllFromTagsInterceptor{}
+}
+
 // resourceValidateRequiredTags validates that required tags are present for a given resource type.
 func resourceValidateRequiredTags() resourceModifyPlanInterceptor {
 	return &resourceValidateRequiredTagsInterceptor{}
@@ -315,21 +387,22 @@ func (r resourceValidateRequiredTagsInterceptor) modifyPlan(ctx context.Context,
 			return
 		}
 
-		if allPlanTags.ContainsAllKeys(reqTags) {
-			return
+		report := func(summary, detail string) {
+			switch policy.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+			}
 		}
 
-		missing := reqTags.Removed(allPlanTags).Keys()
-		slices.Sort(missing)
-
-		summary := "Missing Required Tags"
-		detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-		switch policy.Severity {
-		case "warning":
-			opts.response.Diagnostics.AddAttributeWarning(path.Root(names.AttrTags), summary, detail)
-		default:
-			opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), summary, detail)
+		if !allPlanTags.ContainsAllKeys(reqTags) {
+			missing := reqTags.Removed(allPlanTags).Keys()
+			slices.Sort(missing)
+			report("Missing Required Tags", fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing))
+		}
+		if disallowed := policy.DisallowedTagValues(typeName, allPlanTags); len(disallowed) > 0 {
+			report("Disallowed Tag Values", fmt.Sprintf("An organizational tag policy does not allow the values of the following tags for %s: %s", typeName, disallowed))
 		}
 	}
 }
@@ -409,3 +409,111 @@ func Test_resourceValidateRequiredTagsInterceptor(t *testing.T) {
 		})
 	}
 }
+
+type mockDefaultTagsClient struct {
+	mockRequiredTagsClient
+}
+
+func (c mockDefaultTagsClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	return &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, map[string]string{
+			"Name": "default",
+		}),
+	}
+}
+
+func (c mockDefaultTagsClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
+	return mockIAMServicePackage{}
+}
+
+type mockIAMServicePackage struct {
+	mockServicePackage
+}
+
+func (sp mockIAMServicePackage) ServicePackageName() string {
+	return names.IAM
+}
+
+func Test_tagsResourceInterceptor_modifyPlanCallerManaged(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+
+	var c mockDefaultTagsClient
+	ctx = conns.NewResourceContext(ctx, names.IAM, "Role", "aws_iam_role", "")
+	ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
+
+	// Callers that manage `tags_all` leave it out of the schema, so any attempt to plan it fails.
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"tags": tftags.TagsAttribute(),
+		},
+	}
+	newPlan := func(tags map[string]tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test"),
+				"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tags),
+			}),
+			Schema: resourceSchema,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		plan      tfsdk.Plan
+		wantError bool
+	}{
+		{
+			name: "known tags",
+			plan: newPlan(map[string]tftypes.Value{
+				"Owner": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		{
+			name: "unknown tags",
+			plan: newPlan(map[string]tftypes.Value{
+				"Owner": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		{
+			name: "case-insensitive duplicate of default tag",
+			plan: newPlan(map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			wantError: true,
+		},
+		{
+			name: "destroy",
+			plan: tfsdk.Plan{
+				Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+				Schema: resourceSchema,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tagsResourceInterceptor{tagsAllMode: tftags.TagsAllCallerManaged}
+			opts := interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c: c,
+				request: &resource.ModifyPlanRequest{
+					Plan: tt.plan,
+				},
+				response: &resource.ModifyPlanResponse{
+					Plan: tt.plan,
+				},
+				when: Before,
+			}
+			r.modifyPlan(ctx, opts)
+
+			if got := opts.response.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("HasError() = %t, want %t: %s", got, tt.wantError, opts.response.Diagnostics)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"sync"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/useragent"
 	"github.com/hashicorp/terraform-plugin-framework/action"
@@ -37,14 +38,15 @@ type getAttributeFunc func(context.Context, path.Path, any) diag.Diagnostics
 
 // wrappedDataSource represents an interceptor dispatcher for a Plugin Framework data source.
 type wrappedDataSource struct {
-	inner              datasource.DataSourceWithConfigure
+	inner              func() datasource.DataSourceWithConfigure
 	meta               *conns.AWSClient
 	servicePackageName string
 	spec               *inttypes.ServicePackageFrameworkDataSource
 	interceptors       interceptorInvocations
+	validateSchema     func(context.Context) diag.Diagnostics
 }
 
-func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, servicePackageName string) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, servicePackageName string, validateSchema func(context.Context) diag.Diagnostics) datasource.DataSourceWithConfigure {
 	var isRegionOverrideEnabled bool
 	if regionSpec := spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
@@ -66,14 +68,32 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 		interceptors = append(interceptors, dataSourceTransparentTagging(spec.Tags))
 	}
 
-	inner, _ := spec.Factory(context.TODO())
-
 	return &wrappedDataSource{
-		inner:              inner,
+		inner:              lazyFactory(spec.Factory),
 		servicePackageName: servicePackageName,
 		spec:               spec,
 		interceptors:       interceptors,
+		validateSchema:     validateSchema,
+	}
+}
+
+// lazyFactory returns a function that calls factory on first use only.
+// The inner data source, resource etc. is created on first use, not when the wrapper is created to read its type name.
+func lazyFactory[T any](factory func(context.Context) (T, error)) func() T {
+	return sync.OnceValue(func() T {
+		inner, _ := factory(context.TODO())
+		return inner
+	})
+}
+
+// appendExistenceGuard appends any existence guard interceptor.
+// It must be the last interceptor run Before Create.
+func appendExistenceGuard(interceptors interceptorInvocations, spec *inttypes.ServicePackageFrameworkResource) interceptorInvocations {
+	if spec.ExistenceGuard == nil {
+		return interceptors
 	}
+
+	return append(interceptors, newExistenceGuardInterceptor(spec.TypeName, spec.ExistenceGuard))
 }
 
 // context is run on all wrapped methods before any interceptors.
@@ -130,13 +150,19 @@ func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.Schem
 		return
 	}
 
-	interceptedHandler(w.interceptors.dataSourceSchema(), w.inner.Schema, dataSourceSchemaHasError, w.meta)(ctx, request, response)
+	// The schema is checked against its registration on first use.
+	response.Diagnostics.Append(w.validateSchema(ctx)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	interceptedHandler(w.interceptors.dataSourceSchema(), w.inner().Schema, dataSourceSchemaHasError, w.meta)(ctx, request, response)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
 	// Validate the data source's model against the schema.
-	if v, ok := w.inner.(framework.DataSourceValidateModel); ok {
+	if v, ok := w.inner().(framework.DataSourceValidateModel); ok {
 		response.Diagnostics.Append(v.ValidateModel(ctx, &response.Schema)...)
 		if response.Diagnostics.HasError() {
 			response.Diagnostics.AddError("data source model validation error", w.spec.TypeName)
@@ -154,7 +180,7 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
-	interceptedHandler(w.interceptors.dataSourceRead(), w.inner.Read, dataSourceReadHasError, w.meta)(ctx, request, response)
+	interceptedHandler(w.interceptors.dataSourceRead(), w.inner().Read, dataSourceReadHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
@@ -168,11 +194,11 @@ func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.Co
 		return
 	}
 
-	w.inner.Configure(ctx, request, response)
+	w.inner().Configure(ctx, request, response)
 }
 
 func (w *wrappedDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
-	if v, ok := w.inner.(datasource.DataSourceWithConfigValidators); ok {
+	if v, ok := w.inner().(datasource.DataSourceWithConfigValidators); ok {
 		ctx, diags := w.context(ctx, nil, nil, w.meta)
 		if diags.HasError() {
 			tflog.Warn(ctx, "wrapping ConfigValidators", map[string]any{
@@ -190,7 +216,7 @@ func (w *wrappedDataSource) ConfigValidators(ctx context.Context) []datasource.C
 }
 
 func (w *wrappedDataSource) ValidateConfig(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
-	if v, ok := w.inner.(datasource.DataSourceWithValidateConfig); ok {
+	if v, ok := w.inner().(datasource.DataSourceWithValidateConfig); ok {
 		ctx, diags := w.context(ctx, request.Config.GetAttribute, nil, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
@@ -203,14 +229,15 @@ func (w *wrappedDataSource) ValidateConfig(ctx context.Context, request datasour
 
 // wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
 type wrappedEphemeralResource struct {
-	inner              ephemeral.EphemeralResourceWithConfigure
+	inner              func() ephemeral.EphemeralResourceWithConfigure
 	meta               *conns.AWSClient
 	servicePackageName string
 	spec               *inttypes.ServicePackageEphemeralResource
 	interceptors       interceptorInvocations
+	validateSchema     func(context.Context) diag.Diagnostics
 }
 
-func newWrappedEphemeralResource(spec *inttypes.ServicePackageEphemeralResource, servicePackageName string) ephemeral.EphemeralResourceWithConfigure {
+func newWrappedEphemeralResource(spec *inttypes.ServicePackageEphemeralResource, servicePackageName string, validateSchema func(context.Context) diag.Diagnostics) ephemeral.EphemeralResourceWithConfigure {
 	var isRegionOverrideEnabled bool
 	if regionSpec := spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
@@ -228,13 +255,12 @@ func newWrappedEphemeralResource(spec *inttypes.ServicePackageEphemeralResource,
 		interceptors = append(interceptors, ephemeralResourceSetRegionInResult())
 	}
 
-	inner, _ := spec.Factory(context.TODO())
-
 	return &wrappedEphemeralResource{
-		inner:              inner,
+		inner:              lazyFactory(spec.Factory),
 		servicePackageName: servicePackageName,
 		spec:               spec,
 		interceptors:       interceptors,
+		validateSchema:     validateSchema,
 	}
 }
 
@@ -280,10 +306,16 @@ func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral
 		return
 	}
 
-	interceptedHandler(w.interceptors.ephemeralResourceSchema(), w.inner.Schema, ephemeralSchemaHasError, w.meta)(ctx, request, response)
+	// The schema is checked against its registration on first use.
+	response.Diagnostics.Append(w.validateSchema(ctx)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	interceptedHandler(w.interceptors.ephemeralResourceSchema(), w.inner().Schema, ephemeralSchemaHasError, w.meta)(ctx, request, response)
 
 	// Validate the ephemeral resource's model against the schema.
-	if v, ok := w.inner.(framework.EphemeralResourceValidateModel); ok {
+	if v, ok := w.inner().(framework.EphemeralResourceValidateModel); ok {
 		response.Diagnostics.Append(v.ValidateModel(ctx, &response.Schema)...)
 		if response.Diagnostics.HasError() {
 			response.Diagnostics.AddError("ephemeral resource model validation error", w.spec.TypeName)
@@ -301,7 +333,7 @@ func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.O
 		return
 	}
 
-	interceptedHandler(w.interceptors.ephemeralResourceOpen(), w.inner.Open, ephemeralOpenHasError, w.meta)(ctx, request, response)
+	interceptedHandler(w.interceptors.ephemeralResourceOpen(), w.inner().Open, ephemeralOpenHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
@@ -315,11 +347,11 @@ func (w *wrappedEphemeralResource) Configure(ctx context.Context, request epheme
 		return
 	}
 
-	w.inner.Configure(ctx, request, response)
+	w.inner().Configure(ctx, request, response)
 }
 
 func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
-	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+	if v, ok := w.inner().(ephemeral.EphemeralResourceWithRenew); ok {
 		ctx, diags := w.context(ctx, nil, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
@@ -331,7 +363,7 @@ func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.
 }
 
 func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
-	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+	if v, ok := w.inner().(ephemeral.EphemeralResourceWithClose); ok {
 		ctx, diags := w.context(ctx, nil, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
@@ -343,7 +375,7 @@ func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.
 }
 
 func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
-	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+	if v, ok := w.inner().(ephemeral.EphemeralResourceWithConfigValidators); ok {
 		ctx, diags := w.context(ctx, nil, w.meta)
 		if diags.HasError() {
 			tflog.Warn(ctx, "wrapping ConfigValidators", map[string]any{
@@ -361,7 +393,7 @@ func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephem
 }
 
 func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
-	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+	if v, ok := w.inner().(ephemeral.EphemeralResourceWithValidateConfig); ok {
 		ctx, diags := w.context(ctx, request.Config.GetAttribute, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
@@ -374,14 +406,15 @@ func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request e
 
 // wrappedAction represents an interceptor dispatcher for a Plugin Framework action.
 type wrappedAction struct {
-	inner              action.ActionWithConfigure
+	inner              func() action.ActionWithConfigure
 	meta               *conns.AWSClient
 	servicePackageName string
 	spec               *inttypes.ServicePackageAction
 	interceptors       interceptorInvocations
+	validateSchema     func(context.Context) diag.Diagnostics
 }
 
-func newWrappedAction(spec *inttypes.ServicePackageAction, servicePackageName string) action.ActionWithConfigure {
+func newWrappedAction(spec *inttypes.ServicePackageAction, servicePackageName string, validateSchema func(context.Context) diag.Diagnostics) action.ActionWithConfigure {
 	var isRegionOverrideEnabled bool
 	if regionSpec := spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
@@ -398,13 +431,12 @@ func newWrappedAction(spec *inttypes.ServicePackageAction, servicePackageName st
 		}
 	}
 
-	inner, _ := spec.Factory(context.TODO())
-
 	return &wrappedAction{
-		inner:              inner,
+		inner:              lazyFactory(spec.Factory),
 		servicePackageName: servicePackageName,
 		spec:               spec,
 		interceptors:       interceptors,
+		validateSchema:     validateSchema,
 	}
 }
 
@@ -450,13 +482,19 @@ func (w *wrappedAction) Schema(ctx context.Context, request action.SchemaRequest
 		return
 	}
 
+	// The schema is checked against its registration on first use.
+	response.Diagnostics.Append(w.validateSchema(ctx)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	f := func(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
-		w.inner.Schema(ctx, request, response)
+		w.inner().Schema(ctx, request, response)
 	}
 	interceptedHandler(w.interceptors.actionSchema(), f, actionSchemaHasError, w.meta)(ctx, request, response)
 
 	// Validate the action's model against the schema.
-	if v, ok := w.inner.(framework.ActionValidateModel); ok {
+	if v, ok := w.inner().(framework.ActionValidateModel); ok {
 		response.Diagnostics.Append(v.ValidateModel(ctx, &response.Schema)...)
 		if response.Diagnostics.HasError() {
 			response.Diagnostics.AddError("action model validation error", w.spec.TypeName)
@@ -475,7 +513,7 @@ func (w *wrappedAction) Invoke(ctx context.Context, request action.InvokeRequest
 	}
 
 	f := func(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
-		w.inner.Invoke(ctx, request, response)
+		w.inner().Invoke(ctx, request, response)
 	}
 	interceptedHandler(w.interceptors.actionInvoke(), f, actionInvokeHasError, w.meta)(ctx, request, response)
 }
@@ -491,11 +529,11 @@ func (w *wrappedAction) Configure(ctx context.Context, request action.ConfigureR
 		return
 	}
 
-	w.inner.Configure(ctx, request, response)
+	w.inner().Configure(ctx, request, response)
 }
 
 func (w *wrappedAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
-	if v, ok := w.inner.(action.ActionWithConfigValidators); ok {
+	if v, ok := w.inner().(action.ActionWithConfigValidators); ok {
 		ctx, diags := w.context(ctx, nil, w.meta)
 		if diags.HasError() {
 			tflog.Warn(ctx, "wrapping ConfigValidators", map[string]any{
@@ -513,7 +551,7 @@ func (w *wrappedAction) ConfigValidators(ctx context.Context) []action.ConfigVal
 }
 
 func (w *wrappedAction) ValidateConfig(ctx context.Context, request action.ValidateConfigRequest, response *action.ValidateConfigResponse) {
-	if v, ok := w.inner.(action.ActionWithValidateConfig); ok {
+	if v, ok := w.inner().(action.ActionWithValidateConfig); ok {
 		ctx, diags := w.context(ctx, request.Config.GetAttribute, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
@@ -526,14 +564,15 @@ func (w *wrappedAction) ValidateConfig(ctx context.Context, request action.Valid
 
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
-	inner              resource.ResourceWithConfigure
+	inner              func() resource.ResourceWithConfigure
 	meta               *conns.AWSClient
 	servicePackageName string
 	spec               *inttypes.ServicePackageFrameworkResource
 	interceptors       interceptorInvocations
+	validateSchema     func(context.Context) diag.Diagnostics
 }
 
-func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, servicePackageName string) resource.ResourceWithConfigure {
+func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, servicePackageName string, tagsAllMode tftags.TagsAllMode, validateSchema func(context.Context) diag.Diagnostics) resource.ResourceWithConfigure {
 	var isRegionOverrideEnabled bool
 	if v := spec.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
@@ -559,33 +598,45 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 	}
 
 	if !tfunique.IsHandleNil(spec.Tags) {
-		interceptors = append(interceptors, resourceTransparentTagging(spec.Tags))
+		interceptors = append(interceptors, resourceTransparentTagging(spec.Tags, tagsAllMode))
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
-	inner, _ := spec.Factory(context.TODO())
+	if tagsAllMode == tftags.TagsAllCallerManaged {
+		interceptors = append(interceptors, resourceTagsAllFromTags())
+	}
 
 	if len(spec.Identity.Attributes) == 0 {
+		interceptors = appendExistenceGuard(interceptors, spec)
+
 		return &wrappedResource{
-			inner:              inner,
+			inner:              lazyFactory(spec.Factory),
 			servicePackageName: servicePackageName,
 			spec:               spec,
 			interceptors:       interceptors,
+			validateSchema:     validateSchema,
 		}
 	}
 
 	interceptors = append(interceptors, newIdentityInterceptor(spec.Identity.Attributes))
-	if v, ok := inner.(framework.Identityer); ok {
-		v.SetIdentitySpec(spec.Identity)
-	}
+	interceptors = appendExistenceGuard(interceptors, spec)
+	inner := sync.OnceValue(func() resource.ResourceWithConfigure {
+		inner, _ := spec.Factory(context.TODO())
 
-	if spec.Import.WrappedImport {
-		if v, ok := inner.(framework.ImportByIdentityer); ok {
-			v.SetImportSpec(spec.Import)
+		if v, ok := inner.(framework.Identityer); ok {
+			v.SetIdentitySpec(spec.Identity)
 		}
-		// If the resource does not implement framework.ImportByIdentityer,
-		// it will be caught by `validateResourceSchemas`, so we can ignore it here.
-	}
+
+		if spec.Import.WrappedImport {
+			if v, ok := inner.(framework.ImportByIdentityer); ok {
+				v.SetImportSpec(spec.Import)
+			}
+			// If the resource does not implement framework.ImportByIdentityer,
+			// it will be caught by schema validation, so we can ignore it here.
+		}
+
+		return inner
+	})
 
 	return &wrappedResourceWithIdentity{
 		wrappedResource: wrappedResource{
@@ -593,6 +644,7 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 			servicePackageName: servicePackageName,
 			spec:               spec,
 			interceptors:       interceptors,
+			validateSchema:     validateSchema,
 		},
 	}
 }
@@ -655,10 +707,16 @@ func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaReq
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceSchema(), w.inner.Schema, resourceSchemaHasError, w.meta)(ctx, request, response)
+	// The schema is checked against its registration on first use.
+	response.Diagnostics.Append(w.validateSchema(ctx)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	interceptedHandler(w.interceptors.resourceSchema(), w.inner().Schema, resourceSchemaHasError, w.meta)(ctx, request, response)
 
 	// Validate the resource's model against the schema.
-	if v, ok := w.inner.(framework.ResourceValidateModel); ok {
+	if v, ok := w.inner().(framework.ResourceValidateModel); ok {
 		response.Diagnostics.Append(v.ValidateModel(ctx, &response.Schema)...)
 		if response.Diagnostics.HasError() {
 			response.Diagnostics.AddError("resource model validation error", w.spec.TypeName)
@@ -676,7 +734,7 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceCreate(), w.inner.Create, resourceCreateHasError, w.meta)(ctx, request, response)
+	interceptedHandler(w.interceptors.resourceCreate(), w.inner().Create, resourceCreateHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -686,7 +744,7 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceRead(), w.inner.Read, resourceReadHasError, w.meta)(ctx, request, response)
+	interceptedHandler(w.interceptors.resourceRead(), w.inner().Read, resourceReadHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -696,7 +754,7 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceUpdate(), w.inner.Update, resourceUpdateHasError, w.meta)(ctx, request, response)
+	interceptedHandler(w.interceptors.resourceUpdate(), w.inner().Update, resourceUpdateHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -706,7 +764,7 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceDelete(), w.inner.Delete, resourceDeleteHasError, w.meta)(ctx, request, response)
+	interceptedHandler(w.interceptors.resourceDelete(), w.inner().Delete, resourceDeleteHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
@@ -720,11 +778,11 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 		return
 	}
 
-	w.inner.Configure(ctx, request, response)
+	w.inner().Configure(ctx, request, response)
 }
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+	if v, ok := w.inner().(resource.ResourceWithImportState); ok {
 		ctx, diags := w.context(ctx, nil, nil, w.meta)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
@@ -753,14 +811,14 @@ func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.Modif
 	// We run ModifyPlan interceptors even if the resource has not defined a ModifyPlan method.
 	f := func(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	}
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+	if v, ok := w.inner().(resource.ResourceWithModifyPlan); ok {
 		f = v.ModifyPlan
 	}
 	interceptedHandler(w.interceptors.resourceModifyPlan(), f, resourceModifyPlanHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
-	if v, ok := w.inner.(resource.ResourceWithConfigValidators); ok {
+	if v, ok := w.inner().(resource.ResourceWithConfigValidators); ok {
 		ctx, diags := w.context(ctx, nil, nil, w.meta)
 		if diags.HasError() {
 			tflog.Warn(ctx, "wrapping ConfigValidators", map[string]any{
@@ -784,13 +842,13 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 		return
 	}
 
-	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
+	if v, ok := w.inner().(resource.ResourceWithValidateConfig); ok {
 		v.ValidateConfig(ctx, request, response)
 	}
 }
 
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
-	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
+	if v, ok := w.inner().(resource.ResourceWithUpgradeState); ok {
 		ctx, diags := w.context(ctx, nil, nil, w.meta)
 		if diags.HasError() {
 			tflog.Warn(ctx, "wrapping UpgradeState", map[string]any{
@@ -808,7 +866,7 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 }
 
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
-	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
+	if v, ok := w.inner().(resource.ResourceWithMoveState); ok {
 		ctx, diags := w.context(ctx, nil, nil, w.meta)
 		if diags.HasError() {
 			tflog.Warn(ctx, "wrapping MoveState", map[string]any{
@@ -836,7 +894,7 @@ func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, req re
 }
 
 type wrappedListResourceFramework struct {
-	inner              list.ListResourceWithConfigure
+	inner              func() list.ListResourceWithConfigure
 	meta               *conns.AWSClient
 	servicePackageName string
 	spec               *inttypes.ServicePackageFrameworkListResource
@@ -858,26 +916,30 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 		// TODO: validate region in partition, needs tweaked error message
 	}
 
-	inner := spec.Factory()
+	inner := sync.OnceValue(func() list.ListResourceWithConfigure {
+		inner := spec.Factory()
 
-	if v, ok := inner.(framework.Identityer); ok {
-		v.SetIdentitySpec(spec.Identity)
-	}
-
-	if v, ok := inner.(framework.Lister[listresource.InterceptorParams]); ok {
-		if isRegionOverrideEnabled {
-			v.AppendResultInterceptor(listresource.SetRegionInterceptor())
+		if v, ok := inner.(framework.Identityer); ok {
+			v.SetIdentitySpec(spec.Identity)
 		}
 
-		v.AppendResultInterceptor(listresource.IdentityInterceptor(spec.Identity.Attributes))
+		if v, ok := inner.(framework.Lister[listresource.InterceptorParams]); ok {
+			if isRegionOverrideEnabled {
+				v.AppendResultInterceptor(listresource.SetRegionInterceptor())
+			}
+
+			v.AppendResultInterceptor(listresource.IdentityInterceptor(spec.Identity.Attributes))
 
-		// interceptor to set default types for tags, tags_all, and timeouts objects
-		v.AppendResultInterceptor(listresource.DefaultObjectInterceptor())
+			// interceptor to set default types for tags, tags_all, and timeouts objects
+			v.AppendResultInterceptor(listresource.DefaultObjectInterceptor())
 
-		if !tfunique.IsHandleNil(spec.Tags) {
-			v.AppendResultInterceptor(listresource.TagsInterceptor(spec.Tags))
+			if !tfunique.IsHandleNil(spec.Tags) {
+				v.AppendResultInterceptor(listresource.TagsInterceptor(spec.Tags))
+			}
 		}
-	}
+
+		return inner
+	})
 
 	return &wrappedListResourceFramework{
 		inner:              inner,
@@ -932,7 +994,7 @@ func (w *wrappedListResourceFramework) Configure(ctx context.Context, request re
 		return
 	}
 
-	w.inner.Configure(ctx, request, response)
+	w.inner().Configure(ctx, request, response)
 }
 
 func (w *wrappedListResourceFramework) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
@@ -946,7 +1008,7 @@ func (w *wrappedListResourceFramework) List(ctx context.Context, request list.Li
 		return
 	}
 
-	interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta)(ctx, request, stream)
+	interceptedListHandler(w.interceptors.resourceList(), w.inner().List, w.meta)(ctx, request, stream)
 }
 
 // ListResourceConfigSchema implements list.ListResourceWithConfigure.
@@ -957,7 +1019,7 @@ func (w *wrappedListResourceFramework) ListResourceConfigSchema(ctx context.Cont
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceListResourceConfigSchema(), w.inner.ListResourceConfigSchema, listResourceConfigSchemaHasError, w.meta)(ctx, request, response)
+	interceptedHandler(w.interceptors.resourceListResourceConfigSchema(), w.inner().ListResourceConfigSchema, listResourceConfigSchemaHasError, w.meta)(ctx, request, response)
 }
 
 // Metadata implements list.ListResourceWithConfigure.
@@ -967,7 +1029,7 @@ func (w *wrappedListResourceFramework) Metadata(_ context.Context, request resou
 }
 
 type wrappedListResourceSDK struct {
-	inner              inttypes.ListResourceForSDK
+	inner              func() inttypes.ListResourceForSDK
 	meta               *conns.AWSClient
 	servicePackageName string
 	spec               *inttypes.ServicePackageSDKListResource
@@ -984,21 +1046,25 @@ func newWrappedListResourceSDK(spec *inttypes.ServicePackageSDKListResource, ser
 		// TODO: validate region in partition, needs tweaked error message
 	}
 
-	inner := spec.Factory()
+	inner := sync.OnceValue(func() inttypes.ListResourceForSDK {
+		inner := spec.Factory()
 
-	if v, ok := inner.(framework.WithRegionSpec); ok {
-		v.SetRegionSpec(spec.Region)
-	}
+		if v, ok := inner.(framework.WithRegionSpec); ok {
+			v.SetRegionSpec(spec.Region)
+		}
 
-	if v, ok := inner.(framework.Identityer); ok {
-		v.SetIdentitySpec(spec.Identity)
-	}
+		if v, ok := inner.(framework.Identityer); ok {
+			v.SetIdentitySpec(spec.Identity)
+		}
 
-	if v, ok := inner.(framework.Lister[listresource.InterceptorParamsSDK]); ok {
-		if !tfunique.IsHandleNil(spec.Tags) {
-			v.AppendResultInterceptor(listresource.TagsInterceptorSDK(spec.Tags))
+		if v, ok := inner.(framework.Lister[listresource.InterceptorParamsSDK]); ok {
+			if !tfunique.IsHandleNil(spec.Tags) {
+				v.AppendResultInterceptor(listresource.TagsInterceptorSDK(spec.Tags))
+			}
 		}
-	}
+
+		return inner
+	})
 
 	return &wrappedListResourceSDK{
 		inner:              inner,
@@ -1059,7 +1125,7 @@ func (w *wrappedListResourceSDK) Configure(ctx context.Context, request resource
 		return
 	}
 
-	w.inner.Configure(ctx, request, response)
+	w.inner().Configure(ctx, request, response)
 }
 
 func (w *wrappedListResourceSDK) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
@@ -1073,7 +1139,7 @@ func (w *wrappedListResourceSDK) List(ctx context.Context, request list.ListRequ
 		return
 	}
 
-	interceptedListHandler(w.interceptors.resourceList(), w.inner.List, w.meta)(ctx, request, stream)
+	interceptedListHandler(w.interceptors.resourceList(), w.inner().List, w.meta)(ctx, request, stream)
 }
 
 // ListResourceConfigSchema implements list.ListResourceWithConfigure.
@@ -1084,11 +1150,11 @@ func (w *wrappedListResourceSDK) ListResourceConfigSchema(ctx context.Context, r
 		return
 	}
 
-	interceptedHandler(w.interceptors.resourceListResourceConfigSchema(), w.inner.ListResourceConfigSchema, listResourceConfigSchemaHasError, w.meta)(ctx, request, response)
+	interceptedHandler(w.interceptors.resourceListResourceConfigSchema(), w.inner().ListResourceConfigSchema, listResourceConfigSchemaHasError, w.meta)(ctx, request, response)
 }
 
 func (w *wrappedListResourceSDK) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
-	if v, ok := w.inner.(list.ListResourceWithRawV5Schemas); ok {
+	if v, ok := w.inner().(list.ListResourceWithRawV5Schemas); ok {
 		ctx, diags := w.context(ctx, nil, w.meta)
 		if diags.HasError() {
 			tflog.Warn(ctx, "wrapping Schemas", map[string]any{
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package initreport reports problems found while initializing the provider,
+// e.g. resources with misconfigured schemas or handlers.
+package initreport
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Severity is the severity of a problem.
+type Severity int
+
+const (
+	// SeverityWarning indicates that the offending type is registered but a provider feature,
+	// e.g. transparent tagging or region injection, may not behave correctly for it.
+	SeverityWarning Severity = iota
+	// SeverityError indicates that the offending type is unusable.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// Strictness determines which problems cause provider initialization to fail.
+type Strictness int
+
+const (
+	// Strict fails initialization on any problem. This is the default.
+	Strict Strictness = iota
+	// Lenient fails initialization only on problems with SeverityError.
+	Lenient
+)
+
+// Problem identifies what was wrong.
+type Problem string
+
+const (
+	ProblemDuplicateTypeName       Problem = "duplicate type name"
+	ProblemFactory                 Problem = "factory failed"
+	ProblemIdentity                Problem = "invalid identity"
+	ProblemImport                  Problem = "invalid import"
+	ProblemIncorrectHandlerVariant Problem = "incorrect handler variant"
+	ProblemRegionAttribute         Problem = "region attribute predefined"
+	ProblemTagsAttribute           Problem = "invalid tags attribute"
+)
+
+// Severity returns the severity of the problem.
+func (p Problem) Severity() Severity {
+	switch p {
+	case ProblemRegionAttribute, ProblemTagsAttribute:
+		return SeverityWarning
+	default:
+		return SeverityError
+	}
+}
+
+// Kind is the kind of the offending type.
+type Kind string
+
+const (
+	KindAction            Kind = "action"
+	KindDataSource        Kind = "data source"
+	KindEphemeralResource Kind = "ephemeral resource"
+	KindResource          Kind = "resource"
+)
+
+// Issue is a problem with a single type.
+type Issue struct {
+	Kind     Kind
+	TypeName string
+	Problem  Problem
+	Severity Severity
+	Err      error
+}
+
+func (i Issue) Error() string {
+	return fmt.Sprintf("%s %s %s (%s): %s", i.Severity, i.Kind, i.TypeName, i.Problem, i.Err)
+}
+
+func (i Issue) Unwrap() error {
+	return i.Err
+}
+
+// Report is an aggregated report of problems found while initializing the provider.
+// A Report is not safe for concurrent use.
+type Report struct {
+	Issues []Issue
+}
+
+var _ error = (*Report)(nil)
+
+// Add records a problem with the specified type.
+func (r *Report) Add(kind Kind, typeName string, problem Problem, err error) {
+	r.Issues = append(r.Issues, Issue{
+		Kind:     kind,
+		TypeName: typeName,
+		Problem:  problem,
+		Severity: problem.Severity(),
+		Err:      err,
+	})
+}
+
+// Append records the issues from another report.
+func (r *Report) Append(other *Report) {
+	if other != nil {
+		r.Issues = append(r.Issues, other.Issues...)
+	}
+}
+
+// HasErrors returns whether the report has any issues with SeverityError.
+func (r *Report) HasErrors() bool {
+	return slices.ContainsFunc(r.Issues, func(i Issue) bool {
+		return i.Severity == SeverityError
+	})
+}
+
+// Warnings returns the issues with SeverityWarning.
+func (r *Report) Warnings() []Issue {
+	return slices.DeleteFunc(slices.Clone(r.Issues), func(i Issue) bool {
+		return i.Severity != SeverityWarning
+	})
+}
+
+// Err returns the report as an error if it has issues that fail initialization with the specified strictness.
+func (r *Report) Err(strictness Strictness) error {
+	switch {
+	case strictness == Lenient && !r.HasErrors():
+		return nil
+	case len(r.Issues) == 0:
+		return nil
+	default:
+		return r
+	}
+}
+
+func (r *Report) Error() string {
+	lines := make([]string, 0, len(r.Issues))
+	for _, i := range r.Issues {
+		lines = append(lines, i.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap returns the issues as errors.
+func (r *Report) Unwrap() []error {
+	errs := make([]error, 0, len(r.Issues))
+	for _, i := range r.Issues {
+		errs = append(errs, i)
+	}
+	return errs
+}
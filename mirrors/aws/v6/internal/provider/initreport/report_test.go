@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package initreport
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReportErr(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("boom")
+
+	testCases := map[string]struct {
+		problems      []Problem
+		strictness    Strictness
+		expectedErr   bool
+		expectedWarns int
+	}{
+		"empty strict": {
+			strictness: Strict,
+		},
+		"empty lenient": {
+			strictness: Lenient,
+		},
+		"warning strict": {
+			problems:      []Problem{ProblemTagsAttribute},
+			strictness:    Strict,
+			expectedErr:   true,
+			expectedWarns: 1,
+		},
+		"warning lenient": {
+			problems:      []Problem{ProblemRegionAttribute, ProblemTagsAttribute},
+			strictness:    Lenient,
+			expectedWarns: 2,
+		},
+		"error lenient": {
+			problems:      []Problem{ProblemTagsAttribute, ProblemDuplicateTypeName},
+			strictness:    Lenient,
+			expectedErr:   true,
+			expectedWarns: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var report Report
+			for _, problem := range testCase.problems {
+				report.Add(KindResource, "aws_test", problem, errBoom)
+			}
+
+			err := report.Err(testCase.strictness)
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Errorf("error: got %v, want error %t", err, want)
+			}
+			if err != nil {
+				var r *Report
+				if !errors.As(err, &r) {
+					t.Errorf("error: got %T, want *Report", err)
+				}
+				if !errors.Is(err, errBoom) {
+					t.Errorf("error: got %v, want to wrap %v", err, errBoom)
+				}
+			}
+			if got, want := len(report.Warnings()), testCase.expectedWarns; got != want {
+				t.Errorf("warnings: got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestIssueError(t *testing.T) {
+	t.Parallel()
+
+	var report Report
+	report.Add(KindDataSource, "aws_test", ProblemIncorrectHandlerVariant, errors.New("incorrect Read handler variant"))
+
+	if got, want := report.Error(), "error data source aws_test (incorrect handler variant): incorrect Read handler variant"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"unique"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,7 +17,9 @@ import (
 	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/sdkv2"
 	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/tags/tagpolicy"
 	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/types/option"
 	tfunique "github.com/blampe/patches/mirrors/aws/v6/internal/unique"
 	"github.com/blampe/patches/mirrors/aws/v6/names"
 )
@@ -103,6 +106,40 @@ func (h HTags) ListTags(ctx context.Context, sp conns.ServicePackage, c taggingA
 	return err
 }
 
+type bulkTaggingAWSClient interface {
+	taggingAWSClient
+	BulkTagRefreshEnabled(context.Context) bool
+	BulkListTags(context.Context, string) (map[string]string, error)
+	Region(context.Context) string
+}
+
+// RefreshTags reads the tags of a resource that is already in state during a refresh, e.g. not on import.
+// If bulk tag refresh is enabled and the resource type is supported by the Resource Groups Tagging API,
+// tags are read in bulk, otherwise, or if the resource isn't found in bulk, the service package's resource list tags method is called.
+func (h HTags) RefreshTags(ctx context.Context, sp conns.ServicePackage, c taggingAWSClient, typeName, identifier string) error {
+	if v, ok := c.(bulkTaggingAWSClient); ok && v.BulkTagRefreshEnabled(ctx) && tagpolicy.IsSupported(typeName) {
+		if resourceARN, err := arn.Parse(identifier); err == nil && resourceARN.Region == v.Region(ctx) {
+			tags, err := v.BulkListTags(ctx, identifier)
+			switch {
+			case err == nil:
+				if inContext, ok := tftags.FromContext(ctx); ok {
+					inContext.TagsOut = option.Some(tftags.New(ctx, tags))
+				}
+				return nil
+			case retry.NotFound(err):
+				// Resources that have never been tagged may be unknown to the Resource Groups Tagging API.
+				tflog.Debug(ctx, "Resource not found in bulk tags, falling back to service API")
+			default:
+				tflog.Warn(ctx, "Listing tags in bulk failed, falling back to service API", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}
+	}
+
+	return h.ListTags(ctx, sp, c, identifier)
+}
+
 // If the service package has a generic resource update tags methods, call it.
 func (h HTags) UpdateTags(ctx context.Context, sp conns.ServicePackage, c taggingAWSClient, identifier string, oldTags, newTags any) error {
 	var err error
@@ -130,5 +167,17 @@ func (h HTags) UpdateTags(ctx context.Context, sp conns.ServicePackage, c taggin
 		err = nil
 	}
 
+	// Tags read in bulk during a later refresh must not be stale.
+	h.InvalidateBulkTags(ctx, c, identifier)
+
 	return err
 }
+
+// InvalidateBulkTags discards any tags of the resource read in bulk, e.g. after the resource is created or updated.
+func (h HTags) InvalidateBulkTags(ctx context.Context, c taggingAWSClient, identifier string) {
+	if v, ok := c.(interface {
+		InvalidateBulkTags(context.Context, string)
+	}); ok {
+		v.InvalidateBulkTags(ctx, identifier)
+	}
+}
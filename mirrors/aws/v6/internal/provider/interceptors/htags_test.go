@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"unique"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/types/option"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+type mockBulkTaggingAWSClient struct {
+	tags map[string]string
+	err  error
+}
+
+func (mockBulkTaggingAWSClient) Partition(context.Context) string {
+	return "aws"
+}
+
+func (mockBulkTaggingAWSClient) Region(context.Context) string {
+	return "us-west-2" //lintignore:AWSAT003
+}
+
+func (mockBulkTaggingAWSClient) BulkTagRefreshEnabled(context.Context) bool {
+	return true
+}
+
+func (c mockBulkTaggingAWSClient) BulkListTags(context.Context, string) (map[string]string, error) {
+	return c.tags, c.err
+}
+
+type mockTagListerServicePackage struct {
+	conns.ServicePackage
+	tags map[string]string
+}
+
+func (mockTagListerServicePackage) ServicePackageName() string {
+	return names.SQS
+}
+
+func (sp mockTagListerServicePackage) ListTags(ctx context.Context, _ any, _ string) error {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tftags.New(ctx, sp.tags))
+	}
+	return nil
+}
+
+func TestRefreshTags(t *testing.T) {
+	t.Parallel()
+
+	const identifier = "arn:aws:sqs:us-west-2:123456789012:test" //lintignore:AWSAT003,AWSAT005
+
+	bulkTags := map[string]string{"source": "bulk"}
+	serviceTags := map[string]string{"source": "service"}
+
+	testCases := map[string]struct {
+		typeName string
+		client   mockBulkTaggingAWSClient
+		want     map[string]string
+	}{
+		"bulk": {
+			typeName: "aws_sqs_queue",
+			client:   mockBulkTaggingAWSClient{tags: bulkTags},
+			want:     bulkTags,
+		},
+		"not found in bulk": {
+			typeName: "aws_sqs_queue",
+			client:   mockBulkTaggingAWSClient{err: &retry.NotFoundError{}},
+			want:     serviceTags,
+		},
+		"bulk error": {
+			typeName: "aws_sqs_queue",
+			client:   mockBulkTaggingAWSClient{err: errors.New("failed")},
+			want:     serviceTags,
+		},
+		"unsupported type": {
+			typeName: "aws_test",
+			client:   mockBulkTaggingAWSClient{tags: bulkTags},
+			want:     serviceTags,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := tftags.NewContext(t.Context(), nil, nil, nil)
+			h := HTags(unique.Make(inttypes.ServicePackageResourceTags{IdentifierAttribute: names.AttrARN}))
+			sp := mockTagListerServicePackage{tags: serviceTags}
+
+			if err := h.RefreshTags(ctx, sp, testCase.client, testCase.typeName, identifier); err != nil {
+				t.Fatal(err)
+			}
+
+			inContext, _ := tftags.FromContext(ctx)
+			if got, want := inContext.TagsOut.UnwrapOrDefault().Map(), testCase.want; !maps.Equal(got, want) {
+				t.Errorf("tags: got %v, want %v", got, want)
+			}
+		})
+	}
+}
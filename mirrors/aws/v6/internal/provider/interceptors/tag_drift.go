@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+)
+
+const tagDriftSummary = "Out-of-Band Tag Drift"
+
+// TagDrift returns the summary and detail of a warning diagnostic describing tag keys added, removed or changed
+// outside of Terraform, comparing a resource's prior tags_all with the tags read from the service API.
+// Both sets of tags are expected to exclude system tags and any provider configured ignore_tags.
+// ok is false if there is no drift.
+func TagDrift(typeName string, priorTags, apiTags tftags.KeyValueTags) (summary, detail string, ok bool) {
+	added := apiTags.Removed(priorTags).Keys()
+	removed := priorTags.Removed(apiTags).Keys()
+	var changed []string
+	for k := range apiTags.Difference(priorTags) {
+		if _, ok := priorTags[k]; ok {
+			changed = append(changed, k)
+		}
+	}
+
+	if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+		return "", "", false
+	}
+
+	var parts []string
+	for _, v := range []struct {
+		verb string
+		keys []string
+	}{
+		{"added", added},
+		{"removed", removed},
+		{"changed", changed},
+	} {
+		if len(v.keys) > 0 {
+			slices.Sort(v.keys)
+			parts = append(parts, fmt.Sprintf("%s: %s", v.verb, strings.Join(v.keys, ", ")))
+		}
+	}
+
+	detail = fmt.Sprintf("The tags of this %s were modified outside of Terraform since the last refresh (%s).", typeName, strings.Join(parts, "; "))
+
+	return tagDriftSummary, detail, true
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"testing"
+
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+)
+
+func TestTagDrift(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		priorTags  map[string]string
+		apiTags    map[string]string
+		wantDetail string
+		wantOK     bool
+	}{
+		"no tags": {},
+		"unchanged": {
+			priorTags: map[string]string{"Name": "test", "CostCenter": "1234"},
+			apiTags:   map[string]string{"CostCenter": "1234", "Name": "test"},
+		},
+		"added": {
+			priorTags:  map[string]string{"Name": "test"},
+			apiTags:    map[string]string{"Name": "test", "Owner": "team", "CostCenter": "1234"},
+			wantDetail: "The tags of this aws_test were modified outside of Terraform since the last refresh (added: CostCenter, Owner).",
+			wantOK:     true,
+		},
+		"removed": {
+			priorTags:  map[string]string{"Name": "test", "CostCenter": "1234"},
+			apiTags:    map[string]string{"Name": "test"},
+			wantDetail: "The tags of this aws_test were modified outside of Terraform since the last refresh (removed: CostCenter).",
+			wantOK:     true,
+		},
+		"all": {
+			priorTags:  map[string]string{"Name": "test", "CostCenter": "1234", "Project": "x"},
+			apiTags:    map[string]string{"Name": "test2", "CostCenter": "5678", "Owner": "team"},
+			wantDetail: "The tags of this aws_test were modified outside of Terraform since the last refresh (added: Owner; removed: Project; changed: CostCenter, Name).",
+			wantOK:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			summary, detail, ok := TagDrift("aws_test", tftags.New(ctx, testCase.priorTags), tftags.New(ctx, testCase.apiTags))
+
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Fatalf("ok: got %t, want %t", got, want)
+			}
+			if !ok {
+				return
+			}
+			if got, want := summary, tagDriftSummary; got != want {
+				t.Errorf("summary: got %q, want %q", got, want)
+			}
+			if got, want := detail, testCase.wantDetail; got != want {
+				t.Errorf("detail: got %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+)
+
+const caseInsensitiveDuplicateTagsSummary = "Duplicate Tag Keys"
+
+// CaseInsensitiveDuplicateTags returns the summary and detail of an error diagnostic describing keys of provider
+// default_tags that collide with keys of a resource's tags on a service that treats tag keys case-insensitively.
+// ok is false if there are no collisions.
+func CaseInsensitiveDuplicateTags(serviceName string, defaultConfig *tftags.DefaultConfig, tags tftags.KeyValueTags) (summary, detail string, ok bool) {
+	duplicates := defaultConfig.CaseInsensitiveDuplicates(serviceName, tags)
+	if len(duplicates) == 0 {
+		return "", "", false
+	}
+
+	pairs := make([]string, 0, len(duplicates))
+	for defaultKey, key := range duplicates {
+		pairs = append(pairs, fmt.Sprintf("%q and %q", defaultKey, key))
+	}
+	slices.Sort(pairs)
+
+	detail = fmt.Sprintf("Tag keys are case-insensitive for this service, but the following keys of the provider's default_tags "+
+		"and of this resource's tags differ only in case: %s. "+
+		"Use the same case in both, or remove the key from one of them.", strings.Join(pairs, ", "))
+
+	return caseInsensitiveDuplicateTagsSummary, detail, true
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"testing"
+
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+func TestCaseInsensitiveDuplicateTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	defaultConfig := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, map[string]string{
+			"CostCenter": "1234",
+			"Name":       "default",
+		}),
+	}
+	tags := tftags.New(ctx, map[string]string{
+		"costcenter": "5678",
+		"name":       "resource",
+	})
+
+	if _, _, ok := CaseInsensitiveDuplicateTags(names.EC2, defaultConfig, tags); ok {
+		t.Errorf("%s: got duplicates, want none", names.EC2)
+	}
+
+	summary, detail, ok := CaseInsensitiveDuplicateTags(names.IAM, defaultConfig, tags)
+	if !ok {
+		t.Fatalf("%s: got no duplicates, want duplicates", names.IAM)
+	}
+	if got, want := summary, caseInsensitiveDuplicateTagsSummary; got != want {
+		t.Errorf("summary: got %q, want %q", got, want)
+	}
+	if got, want := detail, `Tag keys are case-insensitive for this service, but the following keys of the provider's default_tags and of this resource's tags differ only in case: "CostCenter" and "costcenter", "Name" and "name". Use the same case in both, or remove the key from one of them.`; got != want {
+		t.Errorf("detail: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"fmt"
+
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+const tagLimitExceededSummary = "Too Many Tags"
+
+// TagLimitExceeded returns the summary and detail of an error diagnostic if a resource's tags, including any provider
+// default_tags, exceed the service's per-resource tag limit. System tags don't count towards the limit.
+// ok is false if the limit is not exceeded or not known.
+func TagLimitExceeded(serviceName string, tags tftags.KeyValueTags) (summary, detail string, ok bool) {
+	limit := names.TagsPerResourceLimit(serviceName)
+	if n := len(tags.IgnoreSystem(serviceName)); limit > 0 && n > limit {
+		return tagLimitExceededSummary, fmt.Sprintf("Resources of this service can have at most %d tags, but %d are configured, including any of the provider's default_tags.", limit, n), true
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"fmt"
+	"testing"
+
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+func TestTagLimitExceeded(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	newTags := func(n int) tftags.KeyValueTags {
+		m := map[string]string{"aws:cloudformation:stack-name": "test"}
+		for i := range n {
+			m[fmt.Sprintf("key%d", i)] = "value"
+		}
+		return tftags.New(ctx, m)
+	}
+
+	testCases := []struct {
+		name        string
+		serviceName string
+		tags        tftags.KeyValueTags
+		wantOK      bool
+	}{
+		{
+			name:        "at limit",
+			serviceName: names.IAM,
+			tags:        newTags(50),
+		},
+		{
+			name:        "over limit",
+			serviceName: names.IAM,
+			tags:        newTags(51),
+			wantOK:      true,
+		},
+		{
+			name:        "no limit",
+			serviceName: names.Route53,
+			tags:        newTags(51),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			summary, detail, ok := TagLimitExceeded(testCase.serviceName, testCase.tags)
+
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Fatalf("ok: got %t, want %t", got, want)
+			}
+			if !ok {
+				return
+			}
+			if got, want := summary, tagLimitExceededSummary; got != want {
+				t.Errorf("summary: got %q, want %q", got, want)
+			}
+			if got, want := detail, "Resources of this service can have at most 50 tags, but 51 are configured, including any of the provider's default_tags."; got != want {
+				t.Errorf("detail: got %q, want %q", got, want)
+			}
+		})
+	}
+}
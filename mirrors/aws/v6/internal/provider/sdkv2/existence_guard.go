@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/errs/sdkdiag"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
+)
+
+// existenceGuardInterceptor prevents a resource's Create from silently adopting an existing resource.
+// The lock on the resource's expected identifier is taken Before Create and released Finally,
+// so it must be the last interceptor run Before Create.
+type existenceGuardInterceptor struct {
+	typeName string
+	guard    inttypes.SDKv2ExistenceGuard
+	held     sync.Map // schemaResourceData -> lock key.
+}
+
+func (r *existenceGuardInterceptor) run(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch d, when := opts.d, opts.when; when {
+	case Before:
+		rd, ok := d.(*schema.ResourceData)
+		if !ok {
+			break
+		}
+
+		id, err := r.guard.ExpectedID(ctx, rd, opts.c)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		if id == "" {
+			break
+		}
+
+		key := existenceGuardLockKey(ctx, opts.c, r.typeName, id)
+		if err := conns.GlobalMutexKV.LockContext(ctx, key); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		err = r.guard.Find(ctx, id, opts.c)
+
+		if err == nil {
+			conns.GlobalMutexKV.Unlock(key)
+			return sdkdiag.AppendErrorf(diags, "%s (%s) already exists. To manage it, import it into state", r.typeName, id)
+		}
+		if !retry.NotFound(err) {
+			conns.GlobalMutexKV.Unlock(key)
+			return sdkdiag.AppendErrorf(diags, "checking for existing %s (%s): %s", r.typeName, id, err)
+		}
+
+		r.held.Store(d, key)
+	case Finally:
+		if key, ok := r.held.LoadAndDelete(d); ok {
+			conns.GlobalMutexKV.Unlock(key.(string))
+		}
+	}
+
+	return diags
+}
+
+// existenceGuardLockKey returns the key used to serialize creation of resources with the same identifier.
+func existenceGuardLockKey(ctx context.Context, c awsClient, typeName, id string) string {
+	return fmt.Sprintf("existence-guard/%s/%s/%s/%s", typeName, c.AccountID(ctx), c.Region(ctx), id)
+}
+
+func newExistenceGuardInterceptor(typeName string, guard inttypes.SDKv2ExistenceGuard) interceptorInvocation {
+	return interceptorInvocation{
+		when: Before | Finally,
+		why:  Create,
+		interceptor: &existenceGuardInterceptor{
+			typeName: typeName,
+			guard:    guard,
+		},
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+)
+
+type mockExistenceGuard struct {
+	findErr error
+}
+
+func (g mockExistenceGuard) ExpectedID(_ context.Context, d *schema.ResourceData, _ any) (string, error) {
+	return d.Get("name").(string), nil
+}
+
+func (g mockExistenceGuard) Find(context.Context, string, any) error {
+	return g.findErr
+}
+
+func TestExistenceGuardInterceptor(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+
+	client := mockClient{
+		accountID: "123456789012",
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := map[string]struct {
+		name          string
+		findErr       error
+		expectedError bool
+	}{
+		"exists": {
+			name:          "exists",
+			expectedError: true,
+		},
+		"not found": {
+			name:    "not-found",
+			findErr: &retry.NotFoundError{},
+		},
+		"find error": {
+			name:          "find-error",
+			findErr:       errors.New("boom"),
+			expectedError: true,
+		},
+		"unknown identifier": {},
+	}
+
+	for tname, tc := range testCases {
+		t.Run(tname, func(t *testing.T) {
+			t.Parallel()
+			ctx := t.Context()
+
+			invocation := newExistenceGuardInterceptor("aws_test", mockExistenceGuard{findErr: tc.findErr})
+			interceptor := invocation.interceptor.(*existenceGuardInterceptor)
+
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]any{"name": tc.name})
+
+			opts := crudInterceptorOptions{
+				c:    client,
+				d:    d,
+				when: Before,
+				why:  Create,
+			}
+
+			diags := interceptor.run(ctx, opts)
+			if got, want := diags.HasError(), tc.expectedError; got != want {
+				t.Errorf("HasError: got %t, want %t (%v)", got, want, diags)
+			}
+
+			opts.when = Finally
+			if diags := interceptor.run(ctx, opts); diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+			}
+
+			// The lock must have been released.
+			if tc.name != "" {
+				key := existenceGuardLockKey(ctx, client, "aws_test", tc.name)
+				ctx, cancel := context.WithTimeout(ctx, time.Second)
+				defer cancel()
+				if err := conns.GlobalMutexKV.LockContext(ctx, key); err != nil {
+					t.Fatal(err)
+				}
+				conns.GlobalMutexKV.Unlock(key)
+			}
+		})
+	}
+}
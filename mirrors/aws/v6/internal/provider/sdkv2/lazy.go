@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/initreport"
+)
+
+// lazyResource is a registered data source or resource that is built, and checked, on first use.
+// The registered shell's fields are set from the built data source or resource
+// the first time that the shell's schema is requested.
+type lazyResource struct {
+	shell       *schema.Resource
+	materialize func() *initreport.Report
+}
+
+func newLazyResource(kind initreport.Kind, strictness initreport.Strictness, build func() (*schema.Resource, *initreport.Report)) *lazyResource {
+	l := &lazyResource{
+		shell: &schema.Resource{},
+	}
+
+	var schemaMap map[string]*schema.Schema
+	l.materialize = sync.OnceValue(func() *initreport.Report {
+		r, report := build()
+
+		for _, issue := range report.Issues {
+			log.Printf("[WARN] %s", issue)
+		}
+		if err := report.Err(strictness); err != nil {
+			failResource(kind, r, err)
+		}
+
+		schemaMap = r.SchemaMap()
+		copyResource(l.shell, r)
+
+		return report
+	})
+	// The shell's SchemaFunc is never modified, so can be called concurrently while the shell is materialized.
+	l.shell.SchemaFunc = func() map[string]*schema.Schema {
+		l.materialize()
+		return schemaMap
+	}
+
+	return l
+}
+
+// copyResource copies all of a built data source's or resource's fields, other than its schema, to a shell.
+func copyResource(shell, r *schema.Resource) {
+	to, from := reflect.ValueOf(shell).Elem(), reflect.ValueOf(r).Elem()
+
+	for i := range to.NumField() {
+		switch to.Type().Field(i).Name {
+		case "Schema", "SchemaFunc":
+		default:
+			to.Field(i).Set(from.Field(i))
+		}
+	}
+}
+
+// failResource makes every operation of a data source or resource that failed its checks return the error.
+func failResource(kind initreport.Kind, r *schema.Resource, err error) {
+	crud := func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+		return diag.FromErr(err)
+	}
+
+	// A resource with only ForceNew attributes mustn't define Update.
+	updatable := r.Update != nil || r.UpdateContext != nil || r.UpdateWithoutTimeout != nil
+
+	r.Create, r.Read, r.Update, r.Delete = nil, nil, nil, nil
+	r.CreateContext, r.ReadContext, r.UpdateContext, r.DeleteContext = nil, nil, nil, nil
+	r.ReadWithoutTimeout = crud
+
+	if kind == initreport.KindDataSource {
+		return
+	}
+
+	r.CreateWithoutTimeout, r.DeleteWithoutTimeout = crud, crud
+	if updatable {
+		r.UpdateWithoutTimeout = crud
+	}
+	r.CustomizeDiff = func(context.Context, *schema.ResourceDiff, any) error {
+		return err
+	}
+	if r.Importer != nil {
+		r.Importer = &schema.ResourceImporter{
+			StateContext: func(context.Context, *schema.ResourceData, any) ([]*schema.ResourceData, error) {
+				return nil, err
+			},
+		}
+	}
+}
+
+// grpcProviderServer is a Terraform Plugin SDK v2 provider server that builds
+// data sources and resources before serving any request for them.
+type grpcProviderServer struct {
+	*schema.GRPCProviderServer
+	provider *schema.Provider
+}
+
+// NewGRPCProviderServer returns a terraform-plugin-go protocol v5 provider server for a provider returned by NewProvider.
+// It must be used instead of the provider's `GRPCProvider` method, which doesn't build data sources and resources on first use.
+func NewGRPCProviderServer(p *schema.Provider) tfprotov5.ProviderServer {
+	return &grpcProviderServer{
+		GRPCProviderServer: schema.NewGRPCProviderServer(p),
+		provider:           p,
+	}
+}
+
+func (s *grpcProviderServer) materializeAll() {
+	for _, r := range s.provider.ResourcesMap {
+		r.SchemaMap()
+	}
+	for _, r := range s.provider.DataSourcesMap {
+		r.SchemaMap()
+	}
+}
+
+func (s *grpcProviderServer) materializeResource(typeName string) {
+	if r, ok := s.provider.ResourcesMap[typeName]; ok {
+		r.SchemaMap()
+	}
+}
+
+func (s *grpcProviderServer) materializeDataSource(typeName string) {
+	if r, ok := s.provider.DataSourcesMap[typeName]; ok {
+		r.SchemaMap()
+	}
+}
+
+func (s *grpcProviderServer) GetProviderSchema(ctx context.Context, request *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	s.materializeAll()
+	return s.GRPCProviderServer.GetProviderSchema(ctx, request)
+}
+
+func (s *grpcProviderServer) GetResourceIdentitySchemas(ctx context.Context, request *tfprotov5.GetResourceIdentitySchemasRequest) (*tfprotov5.GetResourceIdentitySchemasResponse, error) {
+	s.materializeAll()
+	return s.GRPCProviderServer.GetResourceIdentitySchemas(ctx, request)
+}
+
+func (s *grpcProviderServer) UpgradeResourceIdentity(ctx context.Context, request *tfprotov5.UpgradeResourceIdentityRequest) (*tfprotov5.UpgradeResourceIdentityResponse, error) {
+	s.materializeResource(request.TypeName)
+	return s.GRPCProviderServer.UpgradeResourceIdentity(ctx, request)
+}
+
+func (s *grpcProviderServer) ValidateResourceTypeConfig(ctx context.Context, request *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	s.materializeResource(request.TypeName)
+	return s.GRPCProviderServer.ValidateResourceTypeConfig(ctx, request)
+}
+
+func (s *grpcProviderServer) UpgradeResourceState(ctx context.Context, request *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	s.materializeResource(request.TypeName)
+	return s.GRPCProviderServer.UpgradeResourceState(ctx, request)
+}
+
+func (s *grpcProviderServer) ReadResource(ctx context.Context, request *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	s.materializeResource(request.TypeName)
+	return s.GRPCProviderServer.ReadResource(ctx, request)
+}
+
+func (s *grpcProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	s.materializeResource(request.TypeName)
+	return s.GRPCProviderServer.PlanResourceChange(ctx, request)
+}
+
+func (s *grpcProviderServer) ApplyResourceChange(ctx context.Context, request *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	s.materializeResource(request.TypeName)
+	return s.GRPCProviderServer.ApplyResourceChange(ctx, request)
+}
+
+func (s *grpcProviderServer) ImportResourceState(ctx context.Context, request *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	s.materializeResource(request.TypeName)
+	return s.GRPCProviderServer.ImportResourceState(ctx, request)
+}
+
+func (s *grpcProviderServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	s.materializeResource(request.TargetTypeName)
+	return s.GRPCProviderServer.MoveResourceState(ctx, request)
+}
+
+func (s *grpcProviderServer) ValidateDataSourceConfig(ctx context.Context, request *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	s.materializeDataSource(request.TypeName)
+	return s.GRPCProviderServer.ValidateDataSourceConfig(ctx, request)
+}
+
+func (s *grpcProviderServer) ReadDataSource(ctx context.Context, request *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	s.materializeDataSource(request.TypeName)
+	return s.GRPCProviderServer.ReadDataSource(ctx, request)
+}
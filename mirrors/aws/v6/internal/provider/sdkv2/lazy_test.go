@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/initreport"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+func TestLazyResource(t *testing.T) {
+	t.Parallel()
+
+	newResource := func() *schema.Resource {
+		return &schema.Resource{
+			SchemaVersion:        1,
+			CreateWithoutTimeout: schema.NoopContext,
+			ReadWithoutTimeout:   schema.NoopContext,
+			DeleteWithoutTimeout: schema.NoopContext,
+			Importer: &schema.ResourceImporter{
+				StateContext: schema.ImportStatePassthroughContext,
+			},
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+		l := newLazyResource(initreport.KindResource, initreport.Strict, func() (*schema.Resource, *initreport.Report) {
+			calls++
+			return newResource(), &initreport.Report{}
+		})
+
+		if got, want := calls, 0; got != want {
+			t.Errorf("build calls before use: got %d, want %d", got, want)
+		}
+
+		for range 2 {
+			if _, ok := l.shell.SchemaMap()[names.AttrName]; !ok {
+				t.Errorf("expected %s attribute", names.AttrName)
+			}
+		}
+
+		if got, want := calls, 1; got != want {
+			t.Errorf("build calls: got %d, want %d", got, want)
+		}
+		if got, want := l.shell.SchemaVersion, 1; got != want {
+			t.Errorf("SchemaVersion: got %d, want %d", got, want)
+		}
+		if l.shell.Importer == nil {
+			t.Error("expected Importer")
+		}
+		if err := l.shell.InternalValidate(nil, true); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		l := newLazyResource(initreport.KindResource, initreport.Strict, func() (*schema.Resource, *initreport.Report) {
+			var report initreport.Report
+			report.Add(initreport.KindResource, "aws_test", initreport.ProblemTagsAttribute, errors.New("bad tags"))
+			return newResource(), &report
+		})
+
+		if got, want := len(l.materialize().Issues), 1; got != want {
+			t.Errorf("issues: got %d, want %d", got, want)
+		}
+		if diags := l.shell.CreateWithoutTimeout(ctx, l.shell.TestResourceData(), nil); !diags.HasError() {
+			t.Error("expected Create error")
+		}
+		if err := l.shell.CustomizeDiff(ctx, nil, nil); err == nil {
+			t.Error("expected CustomizeDiff error")
+		}
+		if _, err := l.shell.Importer.StateContext(ctx, l.shell.TestResourceData(), nil); err == nil {
+			t.Error("expected import error")
+		}
+		if err := l.shell.InternalValidate(nil, true); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("invalid lenient", func(t *testing.T) {
+		t.Parallel()
+
+		l := newLazyResource(initreport.KindResource, initreport.Lenient, func() (*schema.Resource, *initreport.Report) {
+			var report initreport.Report
+			report.Add(initreport.KindResource, "aws_test", initreport.ProblemTagsAttribute, errors.New("bad tags"))
+			return newResource(), &report
+		})
+
+		l.shell.SchemaMap()
+
+		if l.shell.CustomizeDiff != nil {
+			t.Error("expected no CustomizeDiff")
+		}
+	})
+}
+
+func TestGRPCProviderServerGetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	l := newLazyResource(initreport.KindResource, initreport.Strict, func() (*schema.Resource, *initreport.Report) {
+		return &schema.Resource{
+			SchemaVersion:        2,
+			CreateWithoutTimeout: schema.NoopContext,
+			ReadWithoutTimeout:   schema.NoopContext,
+			DeleteWithoutTimeout: schema.NoopContext,
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		}, &initreport.Report{}
+	})
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": l.shell,
+		},
+	}
+
+	response, err := NewGRPCProviderServer(p).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The schema version is read before the schema, so must already be set.
+	if got, want := response.ResourceSchemas["aws_test"].Version, int64(2); got != want {
+		t.Errorf("Version: got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/initreport"
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+)
+
+// ProviderOption represents a NewProvider option.
+type ProviderOption func(*providerOptions)
+
+type providerOptions struct {
+	includeServicePackages []string
+	excludeServicePackages []string
+	tagsAllMode            tftags.TagsAllMode
+	httpTransport          http.RoundTripper
+	report                 *initreport.Report
+	strictness             initreport.Strictness
+	validateSchemas        bool
+}
+
+// WithServicePackages restricts the provider to the named service packages.
+// Names are the service package names (e.g. `names.S3`) returned by `ServicePackageName`.
+// If not specified, all service packages are registered.
+func WithServicePackages(servicePackageNames ...string) ProviderOption {
+	return func(o *providerOptions) {
+		o.includeServicePackages = append(o.includeServicePackages, servicePackageNames...)
+	}
+}
+
+// WithoutServicePackages prevents the named service packages from being registered.
+// Exclusions are applied after any `WithServicePackages` restriction.
+func WithoutServicePackages(servicePackageNames ...string) ProviderOption {
+	return func(o *providerOptions) {
+		o.excludeServicePackages = append(o.excludeServicePackages, servicePackageNames...)
+	}
+}
+
+// WithTagsAllMode sets how resources' `tags_all` attributes are managed.
+// If not specified, `tags_all` is Computed by the provider.
+func WithTagsAllMode(mode tftags.TagsAllMode) ProviderOption {
+	return func(o *providerOptions) {
+		o.tagsAllMode = mode
+	}
+}
+
+// WithHTTPTransport routes all AWS API calls, including those made while configuring the provider,
+// through the specified transport instead of a client built from provider configuration.
+func WithHTTPTransport(transport http.RoundTripper) ProviderOption {
+	return func(o *providerOptions) {
+		o.httpTransport = transport
+	}
+}
+
+// WithInitializationReport records the problems found while initializing the provider in report,
+// failing initialization only on problems that are fatal with the specified strictness.
+func WithInitializationReport(report *initreport.Report, strictness initreport.Strictness) ProviderOption {
+	return func(o *providerOptions) {
+		o.report = report
+		o.strictness = strictness
+	}
+}
+
+// WithSchemaValidation validates resource and data source schemas when the provider is created.
+// This materializes every schema, so it's intended for tests.
+func WithSchemaValidation() ProviderOption {
+	return func(o *providerOptions) {
+		o.validateSchemas = true
+	}
+}
+
+func newProviderOptions(opts ...ProviderOption) providerOptions {
+	var o providerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// filterServicePackages returns the service packages selected by the options.
+// Unknown service package names are reported as an error.
+func (o providerOptions) filterServicePackages(servicePackages []conns.ServicePackage) ([]conns.ServicePackage, error) {
+	if len(o.includeServicePackages) == 0 && len(o.excludeServicePackages) == 0 {
+		return servicePackages, nil
+	}
+
+	known := make(map[string]struct{}, len(servicePackages))
+	for _, sp := range servicePackages {
+		known[sp.ServicePackageName()] = struct{}{}
+	}
+
+	var unknown []string
+	for _, name := range slices.Concat(o.includeServicePackages, o.excludeServicePackages) {
+		if _, ok := known[name]; !ok && !slices.Contains(unknown, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown service packages: %s", strings.Join(unknown, ", "))
+	}
+
+	return slices.DeleteFunc(slices.Clone(servicePackages), func(sp conns.ServicePackage) bool {
+		name := sp.ServicePackageName()
+		if len(o.includeServicePackages) > 0 && !slices.Contains(o.includeServicePackages, name) {
+			return true
+		}
+		return slices.Contains(o.excludeServicePackages, name)
+	}), nil
+}
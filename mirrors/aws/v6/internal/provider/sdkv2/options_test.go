@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+func TestNewProviderWithServicePackages(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	p, err := NewProvider(ctx, WithServicePackages(names.S3, names.IAM), WithoutServicePackages(names.IAM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(p.ResourcesMap) == 0 {
+		t.Fatal("expected resources to be registered")
+	}
+	for typeName := range p.ResourcesMap {
+		if !strings.HasPrefix(typeName, "aws_s3_") {
+			t.Errorf("unexpected resource registered: %s", typeName)
+		}
+	}
+
+	c := p.Meta().(*conns.AWSClient)
+	if sp := c.ServicePackage(ctx, names.S3); sp == nil {
+		t.Errorf("expected service package %q to be registered", names.S3)
+	}
+	if sp := c.ServicePackage(ctx, names.IAM); sp != nil {
+		t.Errorf("expected service package %q not to be registered", names.IAM)
+	}
+}
+
+func TestNewProviderWithUnknownServicePackage(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewProvider(t.Context(), WithServicePackages("notaservice"))
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if got, want := err.Error(), "unknown service packages: notaservice"; got != want {
+		t.Errorf("unexpected error: got %q, want %q", got, want)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"iter"
 	"log"
 	"maps"
+	"net/http"
 	"os"
 	"slices"
 	"strings"
@@ -29,19 +30,18 @@ import (
 	"github.com/blampe/patches/mirrors/aws/v6/internal/errs"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/errs/sdkdiag"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/flex"
-	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/sdkv2/internal/attribute"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/initreport"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/sdkv2/types/nullable"
 	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
 	tfunique "github.com/blampe/patches/mirrors/aws/v6/internal/unique"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/verify"
 	"github.com/blampe/patches/mirrors/aws/v6/names"
 )
 
-var (
-	resourceSchemasValidated bool
-)
-
 type sdkProvider struct {
+	lazyResources   []*lazyResource
+	options         providerOptions
 	provider        *schema.Provider
 	servicePackages iter.Seq2[int, conns.ServicePackage]
 }
@@ -53,10 +53,17 @@ type providerMeta struct {
 
 // NewProvider returns a new, initialized Terraform Plugin SDK v2-style provider instance.
 // The provider instance is fully configured once the `ConfigureContextFunc` has been called.
-func NewProvider(ctx context.Context) (*schema.Provider, error) {
+func NewProvider(ctx context.Context, opts ...ProviderOption) (*schema.Provider, error) {
 	log.Printf("Creating Terraform AWS Provider (SDKv2-style)...")
 
+	options := newProviderOptions(opts...)
+	packages, err := options.filterServicePackages(servicePackages(ctx))
+	if err != nil {
+		return nil, err
+	}
+
 	sdkProvider := &sdkProvider{
+		options: options,
 		provider: &schema.Provider{
 			// This schema must match exactly the Terraform Protocol v6 (Terraform Plugin Framework) provider's schema.
 			// Notably the attributes can have no Default values.
@@ -75,6 +82,20 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+				"bulk_tag_refresh": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Read resource tags during refresh in batches using the Resource Groups Tagging API " +
+						"instead of one call to the resource's service API per resource. Requires the `tag:GetResources` IAM permission.",
+				},
+				"concurrency_limits": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeInt},
+					Description: "Limits the number of concurrent AWS API calls. Keys are service package names, e.g. `route53`, " +
+						"or service package and API operation names separated by a colon, e.g. `route53:ChangeResourceRecordSets`. " +
+						"Values are the maximum number of concurrent calls.",
+				},
 				"custom_ca_bundle": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -96,6 +117,34 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tags to default across all resources. " +
 									"Can also be configured with environment variables like `" + tftags.DefaultTagsEnvVarPrefix + "<tag_name>`.",
 							},
+							"scope": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with settings to default resource tags on selected resources only.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"exclude_keys": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Keys of default resource tags not to apply to the selected resources.",
+										},
+										"resource_types":   tagScopeResourceTypesSchema(),
+										"service_packages": tagScopeServicePackagesSchema(),
+										"tags": {
+											Type:        schema.TypeMap,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tags to default across the selected resources.",
+										},
+									},
+								},
+							},
+							"propagate_at_launch": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether default tags applied to Auto Scaling Groups are propagated to the EC2 instances they launch.",
+							},
 						},
 					},
 				},
@@ -151,6 +200,29 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 								Description: "Resource tag key prefixes to ignore across all resources. " +
 									"Can also be configured with the " + tftags.IgnoreTagsKeyPrefixesEnvVar + " environment variable.",
 							},
+							"scope": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with settings to ignore resource tags on selected resources only.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"key_prefixes": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tag key prefixes to ignore across the selected resources.",
+										},
+										"keys": {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Resource tag keys to ignore across the selected resources.",
+										},
+										"resource_types":   tagScopeResourceTypesSchema(),
+										"service_packages": tagScopeServicePackagesSchema(),
+									},
+								},
+							},
 						},
 					},
 				},
@@ -191,6 +263,45 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 						"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 				},
+				"retry_overrides": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks that override how AWS API errors are retried.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"error_code": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "AWS API error code, e.g. `LimitExceededException`.",
+							},
+							"error_message": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Text that the error message must contain.",
+							},
+							"max_retries": {
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Description: "Maximum number of times a retryable error is retried. Requires `retryable`.",
+							},
+							"operation": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "AWS API operation name, e.g. `CreateFunction`. If not set, the override applies to all of the service's operations.",
+							},
+							"retryable": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether the error is retried.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Service package name, e.g. `lambda`.",
+							},
+						},
+					},
+				},
 				"s3_use_path_style": {
 					Type:     schema.TypeBool,
 					Optional: true,
@@ -255,6 +366,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The region where AWS STS operations will take place. Examples\n" +
 						"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 				},
+				"tag_drift_diagnostics": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Whether to emit a warning during refresh listing the tag keys of a resource " +
+						"added, removed or changed outside of Terraform.",
+				},
 				"tag_policy_compliance": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -264,6 +381,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						`When unset or "disabled", tag policy compliance will not be enforced by the provider. ` +
 						`Can also be configured with the ` + tftags.TagPolicyComplianceEnvVar + ` environment variable.`,
 				},
+				"tag_policy_document": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `An AWS Organizations tag policy document, either its JSON content or the path to a file containing it, ` +
+						`with which to check tag policy compliance instead of the account's effective tag policy. ` +
+						`Has no effect unless tag_policy_compliance is enabled. ` +
+						`Can also be configured with the ` + tftags.TagPolicyDocumentEnvVar + ` environment variable.`,
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -309,7 +434,7 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 			DataSourcesMap: make(map[string]*schema.Resource),
 			ResourcesMap:   make(map[string]*schema.Resource),
 		},
-		servicePackages: slices.All(servicePackages(ctx)),
+		servicePackages: slices.All(packages),
 	}
 
 	sdkProvider.provider.ConfigureContextFunc = sdkProvider.configure
@@ -322,19 +447,18 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 	conns.GlobalMutexKV.Lock(mutexKVKey)
 	defer conns.GlobalMutexKV.Unlock(mutexKVKey)
 
-	// Because we try and share resource schemas as much as possible,
-	// we need to ensure that we only validate the resource schemas once.
-	if !resourceSchemasValidated {
-		if err := sdkProvider.validateResourceSchemas(ctx); err != nil {
-			return nil, err
-		}
+	servicePackageMap, report := sdkProvider.initialize(ctx)
 
-		resourceSchemasValidated = true
+	// Validating resource schemas builds every data source and resource, so is only done on request, e.g. in tests.
+	// Otherwise each is checked on first use.
+	if options.validateSchemas {
+		report.Append(sdkProvider.validateResourceSchemas())
 	}
 
-	servicePackageMap, err := sdkProvider.initialize(ctx)
-
-	if err != nil {
+	if options.report != nil {
+		options.report.Append(report)
+	}
+	if err := report.Err(options.strictness); err != nil {
 		return nil, err
 	}
 
@@ -380,6 +504,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
+		TagDriftDiagnostics:            d.Get("tag_drift_diagnostics").(bool),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
@@ -412,6 +537,21 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.S3USEast1RegionalEndpoint = endpoint
 	}
 
+	if v, ok := d.GetOk("bulk_tag_refresh"); ok {
+		config.BulkTagRefresh = v.(bool)
+	}
+
+	if v, ok := d.GetOk("concurrency_limits"); ok {
+		config.ConcurrencyLimits = make(map[string]int)
+		for k, v := range v.(map[string]any) {
+			config.ConcurrencyLimits[k] = v.(int)
+		}
+	}
+
+	if v, ok := d.GetOk("retry_overrides"); ok && len(v.([]any)) > 0 {
+		config.RetryOverrides = expandRetryOverrides(v.([]any))
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -496,7 +636,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
-	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string))
+	tagCfg, dg := expandTagPolicyConfig(cty.GetAttrPath("tag_policy_compliance"), d.Get("tag_policy_compliance").(string), d.Get("tag_policy_document").(string))
 	diags = append(diags, dg...)
 	if dg.HasError() {
 		return nil, diags
@@ -533,6 +673,9 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	} else {
 		c = new(conns.AWSClient)
 	}
+	if v := p.options.httpTransport; v != nil {
+		c.SetHTTPClient(ctx, &http.Client{Transport: v})
+	}
 	c, ds := config.ConfigureProvider(ctx, c)
 	diags = append(diags, ds...)
 
@@ -544,10 +687,12 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 }
 
 // initialize is called from `New` to perform any Terraform Plugin SDK v2-style initialization.
-func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServicePackage, error) {
+// Data sources and resources are registered as shells that are built, and checked, on first use.
+// Data sources and resources with duplicate type names are not registered.
+func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServicePackage, *initreport.Report) {
 	log.Printf("Initializing Terraform AWS Provider (SDKv2-style)...")
 
-	var errs []error
+	var report initreport.Report
 	servicePackageMap := make(map[string]conns.ServicePackage)
 
 	for _, sp := range p.servicePackages {
@@ -558,364 +703,377 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 			typeName := v.TypeName
 
 			if _, ok := p.provider.DataSourcesMap[typeName]; ok {
-				errs = append(errs, fmt.Errorf("duplicate data source: %s", typeName))
+				report.Add(initreport.KindDataSource, typeName, initreport.ProblemDuplicateTypeName, errors.New("already registered"))
 				continue
 			}
 
-			r := v.Factory()
+			l := newLazyResource(initreport.KindDataSource, p.options.strictness, func() (*schema.Resource, *initreport.Report) {
+				return p.newDataSource(servicePackageName, v)
+			})
+			p.lazyResources = append(p.lazyResources, l)
+			p.provider.DataSourcesMap[typeName] = l.shell
+		}
+
+		for _, resource := range sp.SDKResources(ctx) {
+			typeName := resource.TypeName
 
-			// Ensure that the correct CRUD handler variants are used.
-			if r.Read != nil || r.ReadContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Read handler variant: %s data source", typeName))
+			if _, ok := p.provider.ResourcesMap[typeName]; ok {
+				report.Add(initreport.KindResource, typeName, initreport.ProblemDuplicateTypeName, errors.New("already registered"))
 				continue
 			}
 
-			var isRegionOverrideEnabled bool
-			if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
-				isRegionOverrideEnabled = true
-			}
+			l := newLazyResource(initreport.KindResource, p.options.strictness, func() (*schema.Resource, *initreport.Report) {
+				return p.newResource(servicePackageName, resource)
+			})
+			p.lazyResources = append(p.lazyResources, l)
+			p.provider.ResourcesMap[typeName] = l.shell
+		}
+	}
 
-			var interceptors interceptorInvocations
+	return servicePackageMap, &report
+}
 
-			if isRegionOverrideEnabled {
-				v := v.Region.Value()
-				s := r.SchemaMap()
+// newDataSource builds and checks a registered Terraform Plugin SDK v2-style data source.
+func (p *sdkProvider) newDataSource(servicePackageName string, v *inttypes.ServicePackageSDKDataSource) (*schema.Resource, *initreport.Report) {
+	var report initreport.Report
+	typeName := v.TypeName
+	r := v.Factory()
 
-				if _, ok := s[names.AttrRegion]; !ok {
-					// Inject a top-level "region" attribute.
-					regionSchema := attribute.Region()
+	// Ensure that the correct CRUD handler variants are used.
+	if r.Read != nil || r.ReadContext != nil {
+		report.Add(initreport.KindDataSource, typeName, initreport.ProblemIncorrectHandlerVariant, errors.New("incorrect Read handler variant"))
+		return r, &report
+	}
 
-					if f := r.SchemaFunc; f != nil {
-						r.SchemaFunc = func() map[string]*schema.Schema {
-							s := f()
-							s[names.AttrRegion] = regionSchema
-							return s
-						}
-					} else {
-						r.Schema[names.AttrRegion] = regionSchema
-					}
-				}
+	if problem, err := validateDataSourceSchema(v, r.SchemaMap()); err != nil {
+		report.Add(initreport.KindDataSource, typeName, problem, err)
+	}
 
-				if v.IsValidateOverrideInPartition {
-					interceptors = append(interceptors, interceptorInvocation{
-						when:        Before,
-						why:         Read,
-						interceptor: dataSourceValidateRegion(),
-					})
+	var isRegionOverrideEnabled bool
+	if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		isRegionOverrideEnabled = true
+	}
+
+	var interceptors interceptorInvocations
+
+	if isRegionOverrideEnabled {
+		v := v.Region.Value()
+
+		injectRegionAttribute(r)
+
+		if v.IsValidateOverrideInPartition {
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         Read,
+				interceptor: dataSourceValidateRegion(),
+			})
+		}
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        After,
+			why:         Read,
+			interceptor: setRegionInState(),
+		})
+	}
+
+	if !tfunique.IsHandleNil(v.Tags) {
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before | After,
+			why:         Read,
+			interceptor: dataSourceTransparentTagging(v.Tags),
+		})
+	}
+
+	opts := wrappedDataSourceOptions{
+		bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
+			var overrideRegion string
+
+			if isRegionOverrideEnabled && getAttribute != nil {
+				if region, ok := getAttribute(names.AttrRegion); ok {
+					overrideRegion = region.(string)
 				}
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        After,
-					why:         Read,
-					interceptor: setRegionInState(),
-				})
 			}
 
-			if !tfunique.IsHandleNil(v.Tags) {
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before | After,
-					why:         Read,
-					interceptor: dataSourceTransparentTagging(v.Tags),
-				})
+			ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion)
+			if c, ok := meta.(*conns.AWSClient); ok {
+				ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
+				ctx = c.RegisterLogger(ctx)
 			}
 
-			opts := wrappedDataSourceOptions{
-				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+			if getProviderMeta != nil {
+				var metadata providerMeta
+				if err := getProviderMeta(&metadata); err != nil {
+					return ctx, fmt.Errorf("getting provider_meta: %w", err)
+				}
 
-					if isRegionOverrideEnabled && getAttribute != nil {
-						if region, ok := getAttribute(names.AttrRegion); ok {
-							overrideRegion = region.(string)
-						}
-					}
+				if len(metadata.UserAgent) > 0 {
+					ctx = useragent.Context(ctx, useragent.FromSlice(metadata.UserAgent))
+				}
+			}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion)
-					if c, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
-						ctx = c.RegisterLogger(ctx)
-					}
+			return ctx, nil
+		},
+		interceptors: interceptors,
+		typeName:     typeName,
+	}
+	wrapDataSource(r, opts)
 
-					if getProviderMeta != nil {
-						var metadata providerMeta
-						if err := getProviderMeta(&metadata); err != nil {
-							return ctx, fmt.Errorf("getting provider_meta: %w", err)
-						}
+	return r, &report
+}
 
-						if len(metadata.UserAgent) > 0 {
-							ctx = useragent.Context(ctx, useragent.FromSlice(metadata.UserAgent))
-						}
-					}
+// newResource builds and checks a registered Terraform Plugin SDK v2-style resource.
+func (p *sdkProvider) newResource(servicePackageName string, resource *inttypes.ServicePackageSDKResource) (*schema.Resource, *initreport.Report) {
+	var report initreport.Report
+	typeName := resource.TypeName
+	r := resource.Factory()
 
-					return ctx, nil
-				},
-				interceptors: interceptors,
-				typeName:     typeName,
-			}
-			wrapDataSource(r, opts)
-			p.provider.DataSourcesMap[typeName] = r
-		}
+	// Ensure that the correct CRUD handler variants are used.
+	if r.Create != nil || r.CreateContext != nil {
+		report.Add(initreport.KindResource, typeName, initreport.ProblemIncorrectHandlerVariant, errors.New("incorrect Create handler variant"))
+		return r, &report
+	}
+	if r.Read != nil || r.ReadContext != nil {
+		report.Add(initreport.KindResource, typeName, initreport.ProblemIncorrectHandlerVariant, errors.New("incorrect Read handler variant"))
+		return r, &report
+	}
+	if r.Update != nil || r.UpdateContext != nil {
+		report.Add(initreport.KindResource, typeName, initreport.ProblemIncorrectHandlerVariant, errors.New("incorrect Update handler variant"))
+		return r, &report
+	}
+	if r.Delete != nil || r.DeleteContext != nil {
+		report.Add(initreport.KindResource, typeName, initreport.ProblemIncorrectHandlerVariant, errors.New("incorrect Delete handler variant"))
+		return r, &report
+	}
 
-		for _, resource := range sp.SDKResources(ctx) {
-			typeName := resource.TypeName
+	if problem, err := p.validateResourceSchema(resource, r.SchemaMap()); err != nil {
+		report.Add(initreport.KindResource, typeName, problem, err)
+	}
 
-			if _, ok := p.provider.ResourcesMap[typeName]; ok {
-				errs = append(errs, fmt.Errorf("duplicate resource: %s", typeName))
-				continue
-			}
+	var isRegionOverrideEnabled bool
+	if v := resource.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		isRegionOverrideEnabled = true
+	}
 
-			r := resource.Factory()
+	var interceptors interceptorInvocations
 
-			// Ensure that the correct CRUD handler variants are used.
-			if r.Create != nil || r.CreateContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Create handler variant: %s resource", typeName))
-				continue
-			}
-			if r.Read != nil || r.ReadContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Read handler variant: %s resource", typeName))
-				continue
-			}
-			if r.Update != nil || r.UpdateContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Update handler variant: %s resource", typeName))
-				continue
-			}
-			if r.Delete != nil || r.DeleteContext != nil {
-				errs = append(errs, fmt.Errorf("incorrect Delete handler variant: %s resource", typeName))
-				continue
-			}
+	if isRegionOverrideEnabled {
+		v := resource.Region.Value()
 
-			var isRegionOverrideEnabled bool
-			if v := resource.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
-				isRegionOverrideEnabled = true
-			}
+		// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
+		// The injected "region" attribute isn't ForceNew.
+		if r.UpdateWithoutTimeout == nil {
+			r.UpdateWithoutTimeout = schema.NoopContext
+		}
 
-			var interceptors interceptorInvocations
+		injectRegionAttribute(r)
 
-			if isRegionOverrideEnabled {
-				v := resource.Region.Value()
-				s := r.SchemaMap()
+		if v.IsValidateOverrideInPartition {
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         CustomizeDiff,
+				interceptor: resourceValidateRegion(),
+			})
+		}
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before,
+			why:         CustomizeDiff,
+			interceptor: defaultRegion(),
+		})
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        After,
+			why:         Read,
+			interceptor: setRegionInState(),
+		})
+		// We can't just set the injected "region" attribute to ForceNew because if
+		// a plan is run with '-refresh=false', then after provider v5 to v6 upgrade
+		// the region attribute is not set in state and its value shows a change.
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before,
+			why:         CustomizeDiff,
+			interceptor: forceNewIfRegionChanges(),
+		})
+		if resource.Identity.HasInherentRegion() {
+			interceptors = append(interceptors, resourceImportRegionNoDefault())
+		} else {
+			interceptors = append(interceptors, resourceImportRegion())
+		}
+	}
 
-				if _, ok := s[names.AttrRegion]; !ok {
-					// Inject a top-level "region" attribute.
-					regionSchema := attribute.Region()
+	if !tfunique.IsHandleNil(resource.Tags) {
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before | After | Finally,
+			why:         Create | Read | Update,
+			interceptor: resourceTransparentTagging(resource.Tags),
+		})
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before,
+			why:         CustomizeDiff,
+			interceptor: setTagsAll(p.options.tagsAllMode),
+		})
+		interceptors = append(interceptors, interceptorInvocation{
+			when:        Before,
+			why:         CustomizeDiff,
+			interceptor: validateRequiredTags(),
+		})
+	}
 
-					// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
-					if r.UpdateWithoutTimeout == nil {
-						r.UpdateWithoutTimeout = schema.NoopContext
-					}
+	if len(resource.Identity.Attributes) > 0 {
+		r.Identity = newResourceIdentity(resource.Identity)
 
-					if f := r.SchemaFunc; f != nil {
-						r.SchemaFunc = func() map[string]*schema.Schema {
-							s := f()
-							s[names.AttrRegion] = regionSchema
-							return s
-						}
-					} else {
-						r.Schema[names.AttrRegion] = regionSchema
-					}
-				}
+		if resource.Identity.IsMutable {
+			r.ResourceBehavior.MutableIdentity = true
+		}
 
-				if v.IsValidateOverrideInPartition {
-					interceptors = append(interceptors, interceptorInvocation{
-						when:        Before,
-						why:         CustomizeDiff,
-						interceptor: resourceValidateRegion(),
-					})
-				}
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before,
-					why:         CustomizeDiff,
-					interceptor: defaultRegion(),
-				})
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        After,
-					why:         Read,
-					interceptor: setRegionInState(),
-				})
-				// We can't just set the injected "region" attribute to ForceNew because if
-				// a plan is run with '-refresh=false', then after provider v5 to v6 upgrade
-				// the region attribute is not set in state and its value shows a change.
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before,
-					why:         CustomizeDiff,
-					interceptor: forceNewIfRegionChanges(),
-				})
-				if resource.Identity.HasInherentRegion() {
-					interceptors = append(interceptors, resourceImportRegionNoDefault())
-				} else {
-					interceptors = append(interceptors, resourceImportRegion())
-				}
-			}
+		interceptors = append(interceptors, newIdentityInterceptor(&resource.Identity))
+	}
 
-			if !tfunique.IsHandleNil(resource.Tags) {
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before | After | Finally,
-					why:         Create | Read | Update,
-					interceptor: resourceTransparentTagging(resource.Tags),
-				})
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before,
-					why:         CustomizeDiff,
-					interceptor: setTagsAll(),
-				})
-				interceptors = append(interceptors, interceptorInvocation{
-					when:        Before,
-					why:         CustomizeDiff,
-					interceptor: validateRequiredTags(),
-				})
-			}
+	// Must be the last interceptor run Before Create.
+	if resource.ExistenceGuard != nil {
+		interceptors = append(interceptors, newExistenceGuardInterceptor(typeName, resource.ExistenceGuard))
+	}
 
-			if len(resource.Identity.Attributes) > 0 {
-				r.Identity = newResourceIdentity(resource.Identity)
+	if resource.Import.CustomImport {
+		if r.Importer == nil || r.Importer.StateContext == nil {
+			report.Add(initreport.KindResource, typeName, initreport.ProblemImport, errors.New("uses CustomImport but does not define an import function"))
+			return r, &report
+		}
 
-				if resource.Identity.IsMutable {
-					r.ResourceBehavior.MutableIdentity = true
-				}
+		customResourceImporter(r, &resource.Identity, &resource.Import)
+	}
+	if resource.Import.WrappedImport {
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			report.Add(initreport.KindResource, typeName, initreport.ProblemImport, errors.New("uses WrappedImport but defines an import function"))
+			return r, &report
+		}
 
-				interceptors = append(interceptors, newIdentityInterceptor(&resource.Identity))
-			}
+		if resource.Identity.IsARN {
+			r.Importer = arnIdentityResourceImporter(resource.Identity)
+		} else if resource.Identity.IsSingleton {
+			r.Importer = singletonIdentityResourceImporter(resource.Identity)
+		} else if resource.Identity.IsCustomInherentRegion {
+			r.Importer = customInherentRegionResourceImporter(resource.Identity)
+		} else {
+			r.Importer = newParameterizedIdentityImporter(resource.Identity, &resource.Import)
+		}
+	}
 
-			if resource.Import.CustomImport {
-				if r.Importer == nil || r.Importer.StateContext == nil {
-					errs = append(errs, fmt.Errorf("resource type %s: uses CustomImport but does not define an import function", typeName))
-					continue
+	opts := wrappedResourceOptions{
+		// bootstrapContext is run on all wrapped methods before any interceptors.
+		bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
+			var overrideRegion string
+
+			if isRegionOverrideEnabled && getAttribute != nil {
+				if region, ok := getAttribute(names.AttrRegion); ok && region != nil {
+					overrideRegion = region.(string)
 				}
+			}
 
-				customResourceImporter(r, &resource.Identity, &resource.Import)
+			ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+			if c, ok := meta.(*conns.AWSClient); ok {
+				ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
+				ctx = c.RegisterLogger(ctx)
 			}
-			if resource.Import.WrappedImport {
-				if r.Importer != nil && r.Importer.StateContext != nil {
-					errs = append(errs, fmt.Errorf("resource type %s: uses WrappedImport but defines an import function", typeName))
-					continue
+
+			if getProviderMeta != nil {
+				var metadata providerMeta
+				if err := getProviderMeta(&metadata); err != nil {
+					return ctx, fmt.Errorf("getting provider_meta: %w", err)
 				}
 
-				if resource.Identity.IsARN {
-					r.Importer = arnIdentityResourceImporter(resource.Identity)
-				} else if resource.Identity.IsSingleton {
-					r.Importer = singletonIdentityResourceImporter(resource.Identity)
-				} else if resource.Identity.IsCustomInherentRegion {
-					r.Importer = customInherentRegionResourceImporter(resource.Identity)
-				} else {
-					r.Importer = newParameterizedIdentityImporter(resource.Identity, &resource.Import)
+				if len(metadata.UserAgent) > 0 {
+					ctx = useragent.Context(ctx, useragent.FromSlice(metadata.UserAgent))
 				}
 			}
 
-			opts := wrappedResourceOptions{
-				// bootstrapContext is run on all wrapped methods before any interceptors.
-				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
-					var overrideRegion string
+			return ctx, nil
+		},
+		interceptors: interceptors,
+		typeName:     typeName,
+	}
+	wrapResource(r, opts)
+	if p.options.tagsAllMode == tftags.TagsAllCallerManaged {
+		tagsAllFromTags(r)
+	}
 
-					if isRegionOverrideEnabled && getAttribute != nil {
-						if region, ok := getAttribute(names.AttrRegion); ok && region != nil {
-							overrideRegion = region.(string)
-						}
-					}
+	return r, &report
+}
 
-					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
-					if c, ok := meta.(*conns.AWSClient); ok {
-						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
-						ctx = c.RegisterLogger(ctx)
-					}
+// validateResourceSchemas is called from `New` to build, and so check, every registered data source and resource.
+func (p *sdkProvider) validateResourceSchemas() *initreport.Report {
+	var report initreport.Report
 
-					if getProviderMeta != nil {
-						var metadata providerMeta
-						if err := getProviderMeta(&metadata); err != nil {
-							return ctx, fmt.Errorf("getting provider_meta: %w", err)
-						}
+	for _, l := range p.lazyResources {
+		report.Append(l.materialize())
+	}
 
-						if len(metadata.UserAgent) > 0 {
-							ctx = useragent.Context(ctx, useragent.FromSlice(metadata.UserAgent))
-						}
-					}
+	return &report
+}
 
-					return ctx, nil
-				},
-				interceptors: interceptors,
-				typeName:     typeName,
+// validateDataSourceSchema checks a Terraform Plugin SDK v2-style data source's schema against its registration.
+func validateDataSourceSchema(v *inttypes.ServicePackageSDKDataSource, s map[string]*schema.Schema) (initreport.Problem, error) {
+	if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		if _, ok := s[names.AttrRegion]; ok {
+			return initreport.ProblemRegionAttribute, fmt.Errorf("`%s` attribute is defined", names.AttrRegion)
+		}
+	}
+
+	if !tfunique.IsHandleNil(v.Tags) {
+		// The data source has opted in to transparent tagging.
+		// Ensure that the schema look OK.
+		if v, ok := s[names.AttrTags]; ok {
+			if !v.Computed {
+				return initreport.ProblemTagsAttribute, fmt.Errorf("`%s` attribute must be Computed", names.AttrTags)
 			}
-			wrapResource(r, opts)
-			p.provider.ResourcesMap[typeName] = r
+		} else {
+			return initreport.ProblemTagsAttribute, fmt.Errorf("no `%s` attribute defined in schema", names.AttrTags)
 		}
 	}
 
-	return servicePackageMap, errors.Join(errs...)
+	return "", nil
 }
 
-// validateResourceSchemas is called from `New` to validate Terraform Plugin SDK v2-style resource schemas.
-func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
-	var errs []error
-
-	for _, sp := range p.servicePackages {
-		for _, v := range sp.SDKDataSources(ctx) {
-			typeName := v.TypeName
-			r := v.Factory()
-			s := r.SchemaMap()
+// validateResourceSchema checks a Terraform Plugin SDK v2-style resource's schema against its registration.
+func (p *sdkProvider) validateResourceSchema(resource *inttypes.ServicePackageSDKResource, s map[string]*schema.Schema) (initreport.Problem, error) {
+	if v := resource.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
+		if _, ok := s[names.AttrRegion]; ok {
+			return initreport.ProblemRegionAttribute, fmt.Errorf("`%s` attribute is defined", names.AttrRegion)
+		}
+	}
 
-			if v := v.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
-				if _, ok := s[names.AttrRegion]; ok {
-					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.AttrRegion, typeName))
-					continue
-				}
+	if !tfunique.IsHandleNil(resource.Tags) {
+		// The resource has opted in to transparent tagging.
+		// Ensure that the schema look OK.
+		if v, ok := s[names.AttrTags]; ok {
+			if v.Computed {
+				return initreport.ProblemTagsAttribute, fmt.Errorf("`%s` attribute cannot be Computed", names.AttrTags)
 			}
-
-			if !tfunique.IsHandleNil(v.Tags) {
-				// The data source has opted in to transparent tagging.
-				// Ensure that the schema look OK.
-				if v, ok := s[names.AttrTags]; ok {
-					if !v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s data source", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s data source", names.AttrTags, typeName))
-					continue
-				}
+		} else {
+			return initreport.ProblemTagsAttribute, fmt.Errorf("no `%s` attribute defined in schema", names.AttrTags)
+		}
+		if v, ok := s[names.AttrTagsAll]; ok {
+			if !v.Computed {
+				return initreport.ProblemTagsAttribute, fmt.Errorf("`%s` attribute must be Computed", names.AttrTagsAll)
 			}
+		} else {
+			return initreport.ProblemTagsAttribute, fmt.Errorf("no `%s` attribute defined in schema", names.AttrTagsAll)
 		}
+	}
 
-		for _, resource := range sp.SDKResources(ctx) {
-			typeName := resource.TypeName
-			r := resource.Factory()
-			s := r.SchemaMap()
-
-			if v := resource.Region; !tfunique.IsHandleNil(v) && v.Value().IsOverrideEnabled {
-				if _, ok := s[names.AttrRegion]; ok {
-					errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.AttrRegion, typeName))
-					continue
-				}
-			}
-
-			if !tfunique.IsHandleNil(resource.Tags) {
-				// The resource has opted in to transparent tagging.
-				// Ensure that the schema look OK.
-				if v, ok := s[names.AttrTags]; ok {
-					if v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute cannot be Computed: %s resource", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s resource", names.AttrTags, typeName))
-					continue
-				}
-				if v, ok := s[names.AttrTagsAll]; ok {
-					if !v.Computed {
-						errs = append(errs, fmt.Errorf("`%s` attribute must be Computed: %s resource", names.AttrTags, typeName))
-						continue
-					}
-				} else {
-					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s resource", names.AttrTagsAll, typeName))
-					continue
-				}
+	if p.options.tagsAllMode == tftags.TagsAllCallerManaged {
+		// `tags_all` schema is copied from `tags`.
+		if _, ok := s[names.AttrTagsAll]; ok {
+			if _, ok := s[names.AttrTags]; !ok {
+				return initreport.ProblemTagsAttribute, fmt.Errorf("`%s` attribute defined without `%s`", names.AttrTagsAll, names.AttrTags)
 			}
+		}
+	}
 
-			if resource.Identity.IsCustomInherentRegion {
-				if resource.Identity.IsGlobalResource {
-					errs = append(errs, fmt.Errorf("`IsCustomInherentRegion` is not supported for Global resources: %s resource", typeName))
-					continue
-				}
-			}
+	if resource.Identity.IsCustomInherentRegion {
+		if resource.Identity.IsGlobalResource {
+			return initreport.ProblemIdentity, errors.New("`IsCustomInherentRegion` is not supported for Global resources")
 		}
 	}
 
-	return errors.Join(errs...)
+	return "", nil
 }
 
 func assumeRoleSchema() *schema.Schema {
@@ -1167,17 +1325,70 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 		maps.Copy(tags, cfgTags)
 	}
 
-	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+	var rules []tftags.DefaultRule
+	if v, ok := tfMap["scope"].([]any); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			rule := tftags.DefaultRule{
+				Scope: expandTagScope(tfMap),
+			}
+			if v, ok := tfMap["tags"].(map[string]any); ok && len(v) > 0 {
+				rule.Tags = tftags.New(ctx, v)
+			}
+			if v, ok := tfMap["exclude_keys"].(*schema.Set); ok && v.Len() > 0 {
+				rule.ExcludeKeys = tftags.New(ctx, v.List())
+			}
+
+			rules = append(rules, rule)
+		}
+	}
+
+	if len(tags) > 0 || len(rules) > 0 {
+		defaultConfig := &tftags.DefaultConfig{
+			Rules: rules,
 		}
+		if v, ok := tfMap["propagate_at_launch"].(bool); ok {
+			defaultConfig.PropagateAtLaunch = v
+		}
+		if len(tags) > 0 {
+			defaultConfig.Tags = tftags.New(ctx, tags)
+		}
+
+		return defaultConfig
 	}
 
 	return nil
 }
 
+func expandRetryOverrides(tfList []any) map[string][]conns.RetryOverride {
+	overrides := make(map[string][]conns.RetryOverride)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := tfMap["service"].(string)
+		overrides[servicePackageName] = append(overrides[servicePackageName], conns.RetryOverride{
+			Operation:    tfMap["operation"].(string),
+			ErrorCode:    tfMap["error_code"].(string),
+			ErrorMessage: tfMap["error_message"].(string),
+			Retryable:    tfMap["retryable"].(bool),
+			MaxRetries:   tfMap["max_retries"].(int),
+		})
+	}
+
+	return overrides
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
+	var rules []tftags.IgnoreRule
 
 	if tfMap != nil {
 		if v, ok := tfMap["keys"].(*schema.Set); ok {
@@ -1186,6 +1397,26 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 		if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
 			keyPrefixes = v.List()
 		}
+		if v, ok := tfMap["scope"].([]any); ok {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				rule := tftags.IgnoreRule{
+					Scope: expandTagScope(tfMap),
+				}
+				if v, ok := tfMap["keys"].(*schema.Set); ok && v.Len() > 0 {
+					rule.Keys = tftags.New(ctx, v.List())
+				}
+				if v, ok := tfMap["key_prefixes"].(*schema.Set); ok && v.Len() > 0 {
+					rule.KeyPrefixes = tftags.New(ctx, v.List())
+				}
+
+				rules = append(rules, rule)
+			}
+		}
 	}
 
 	if v := os.Getenv(tftags.IgnoreTagsKeysEnvVar); v != "" {
@@ -1209,11 +1440,13 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	// - Return nil when no keys or prefixes are set
 	// - For a non-nil return, `keys` or `key_prefixes` should be
 	//   nil if empty (versus a zero-value `KeyValueTags` struct)
-	if len(keys) == 0 && len(keyPrefixes) == 0 {
+	if len(keys) == 0 && len(keyPrefixes) == 0 && len(rules) == 0 {
 		return nil
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	ignoreConfig := &tftags.IgnoreConfig{
+		Rules: rules,
+	}
 	if len(keys) > 0 {
 		ignoreConfig.Keys = tftags.New(ctx, keys)
 	}
@@ -1224,13 +1457,48 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreC
 	return ignoreConfig
 }
 
-func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
+func expandTagScope(tfMap map[string]any) tftags.Scope {
+	var scope tftags.Scope
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		scope.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["service_packages"].(*schema.Set); ok && v.Len() > 0 {
+		scope.ServicePackages = flex.ExpandStringValueSet(v)
+	}
+
+	return scope
+}
+
+func tagScopeResourceTypesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Terraform resource types to select, in which `*` matches any sequence of characters, e.g. `aws_iam_*`.",
+	}
+}
+
+func tagScopeServicePackagesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Service packages whose resources to select, e.g. `ec2`.",
+	}
+}
+
+func expandTagPolicyConfig(path cty.Path, severity, document string) (*tftags.TagPolicyConfig, diag.Diagnostics) {
+	if document == "" {
+		document = os.Getenv(tftags.TagPolicyDocumentEnvVar)
+	}
+
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
 	case severity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: severity}, validateTagPolicySeverity(path, severity)
+		return &tftags.TagPolicyConfig{Severity: severity, Document: document}, validateTagPolicySeverity(path, severity)
 	case envSeverity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateTagPolicySeverityEnvVar(envSeverity)
+		return &tftags.TagPolicyConfig{Severity: envSeverity, Document: document}, validateTagPolicySeverityEnvVar(envSeverity)
 	}
 
 	return nil, nil
@@ -32,7 +32,8 @@ func TestProvider(t *testing.T) {
 	t.Parallel()
 
 	ctx := t.Context()
-	p, err := NewProvider(ctx)
+	// InternalValidate inspects every resource, so build them all.
+	p, err := NewProvider(ctx, WithSchemaValidation())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -45,6 +46,15 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestValidateResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	if _, err := NewProvider(ctx, WithSchemaValidation()); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -5,14 +5,40 @@ package sdkv2
 
 import (
 	"context"
+	"maps"
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/errs/sdkdiag"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/sdkv2/internal/attribute"
 	"github.com/blampe/patches/mirrors/aws/v6/names"
 )
 
+// injectRegionAttribute adds a top-level "region" attribute to a resource's or data source's schema.
+// Schemas built by a `SchemaFunc` are rewritten lazily.
+func injectRegionAttribute(r *schema.Resource) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			return regionSchemaInjected(f())
+		}
+	} else {
+		r.Schema = regionSchemaInjected(r.Schema)
+	}
+}
+
+func regionSchemaInjected(s map[string]*schema.Schema) map[string]*schema.Schema {
+	if _, ok := s[names.AttrRegion]; ok {
+		return s
+	}
+
+	// Schemas may be shared between resources and provider instances, don't modify in place.
+	s = maps.Clone(s)
+	s[names.AttrRegion] = attribute.Region()
+
+	return s
+}
+
 func resourceValidateRegion() customizeDiffInterceptor {
 	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
 		c := opts.c
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+func TestInjectRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Schema", func(t *testing.T) {
+		t.Parallel()
+
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		}
+		injectRegionAttribute(r)
+
+		if _, ok := r.Schema[names.AttrRegion]; !ok {
+			t.Errorf("expected %s attribute", names.AttrRegion)
+		}
+	})
+
+	t.Run("SchemaFunc", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+		r := &schema.Resource{
+			SchemaFunc: func() map[string]*schema.Schema {
+				calls++
+				return map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				}
+			},
+		}
+		injectRegionAttribute(r)
+
+		if calls != 0 {
+			t.Errorf("expected SchemaFunc not to be called, got %d calls", calls)
+		}
+		if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+			t.Errorf("expected %s attribute", names.AttrRegion)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+// tagsAllFromTags rewrites a resource's `tags_all` schema to match its `tags` schema.
+// Used when the caller copies `tags` into `tags_all` before the provider sees the configuration.
+// Schemas built by a `SchemaFunc` are rewritten lazily.
+func tagsAllFromTags(r *schema.Resource) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			return tagsAllSchemaFromTags(f())
+		}
+	} else {
+		r.Schema = tagsAllSchemaFromTags(r.Schema)
+	}
+}
+
+func tagsAllSchemaFromTags(s map[string]*schema.Schema) map[string]*schema.Schema {
+	if _, ok := s[names.AttrTagsAll]; !ok {
+		return s
+	}
+	tags, ok := s[names.AttrTags]
+	if !ok {
+		// Reported by `validateResourceSchemas`.
+		return s
+	}
+
+	// Schemas may be shared between resources, don't modify in place.
+	s = maps.Clone(s)
+	tagsAll := *tags
+	s[names.AttrTagsAll] = &tagsAll
+
+	return s
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+func TestTagsAllFromTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource *schema.Resource
+	}{
+		"Schema": {
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrTags:    tftags.TagsSchemaForceNew(),
+					names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				},
+			},
+		},
+		"SchemaFunc": {
+			resource: &schema.Resource{
+				SchemaFunc: func() map[string]*schema.Schema {
+					return map[string]*schema.Schema{
+						names.AttrTags:    tftags.TagsSchemaForceNew(),
+						names.AttrTagsAll: tftags.TagsSchemaComputed(),
+					}
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testCase.resource
+			tagsAllFromTags(r)
+
+			tagsAll := r.SchemaMap()[names.AttrTagsAll]
+			if tagsAll.Computed {
+				t.Errorf("expected %s not to be Computed", names.AttrTagsAll)
+			}
+			if !tagsAll.ForceNew {
+				t.Errorf("expected %s to be ForceNew", names.AttrTagsAll)
+			}
+			if !tftags.TagsSchemaComputed().Computed {
+				t.Error("shared schema was modified")
+			}
+		})
+	}
+}
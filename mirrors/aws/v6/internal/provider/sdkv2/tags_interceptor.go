@@ -5,10 +5,12 @@ package sdkv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"unique"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,7 +41,7 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 		return diags
 	}
 
-	sp, serviceName, resourceName, _, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
+	sp, serviceName, resourceName, typeName, tagsInContext, ok := interceptors.InfoFromContext(ctx, c)
 	if !ok {
 		return diags
 	}
@@ -86,12 +88,26 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 
 			fallthrough
 		case Create, Update:
+			// Some old resources may not have the required attribute set after Read:
+			// https://github.com/blampe/patches/mirrors/aws/v6/issues/31180
+			identifier := r.GetIdentifierSDKv2(ctx, d)
+
+			// Tags read in bulk during a later refresh must not be stale.
+			if why != Read && identifier != "" {
+				r.InvalidateBulkTags(ctx, c, identifier)
+			}
+
 			// If the R handler didn't set tags, try and read them from the service API.
 			if tagsInContext.TagsOut.IsNone() {
-				// Some old resources may not have the required attribute set after Read:
-				// https://github.com/blampe/patches/mirrors/aws/v6/issues/31180
-				if identifier := r.GetIdentifierSDKv2(ctx, d); identifier != "" {
-					if err := r.ListTags(ctx, sp, c, identifier); err != nil {
+				if identifier != "" {
+					var err error
+					if why == Read && hasPriorTagsAll(d) {
+						// Tags may be read in bulk during a refresh, but not on import or right after they were written.
+						err = r.RefreshTags(ctx, sp, c, typeName, identifier)
+					} else {
+						err = r.ListTags(ctx, sp, c, identifier)
+					}
+					if err != nil {
 						return sdkdiag.AppendErrorf(diags, "listing tags for %s %s (%s): %s", serviceName, resourceName, identifier, err)
 					}
 				}
@@ -100,6 +116,29 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 			// Remove any provider configured ignore_tags and system tags from those returned from the service API.
 			tags := tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(sp.ServicePackageName()).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 
+			// Report tags changed outside of Terraform since the prior state, e.g. not on import.
+			if why == Read && c.TagDriftDiagnostics(ctx) {
+				if state := d.GetRawState(); !state.IsNull() && state.IsKnown() {
+					if s := state.GetAttr(names.AttrTagsAll); !s.IsNull() && s.IsWhollyKnown() {
+						priorTags := make(map[string]string)
+						for k, v := range s.AsValueMap() {
+							if !v.IsNull() {
+								priorTags[k] = v.AsString()
+							}
+						}
+
+						if summary, detail, ok := interceptors.TagDrift(typeName, tftags.New(ctx, priorTags), tags); ok {
+							diags = append(diags, diag.Diagnostic{
+								Severity:      diag.Warning,
+								Summary:       summary,
+								Detail:        detail,
+								AttributePath: cty.GetAttrPath(names.AttrTagsAll),
+							})
+						}
+					}
+				}
+			}
+
 			// The resource's configured tags can now include duplicate tags that have been configured on the provider.
 			if err := d.Set(names.AttrTags, tags.ResolveDuplicates(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), d, names.AttrTags, nil).Map()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
@@ -174,6 +213,12 @@ func (r tagsResourceCRUDInterceptor) run(ctx context.Context, opts crudIntercept
 	return diags
 }
 
+// hasPriorTagsAll returns whether the resource's prior state has tags_all, i.e. the resource is being refreshed.
+func hasPriorTagsAll(d schemaResourceData) bool {
+	state := d.GetRawState()
+	return !state.IsNull() && state.IsKnown() && state.Type().HasAttribute(names.AttrTagsAll) && !state.GetAttr(names.AttrTagsAll).IsNull()
+}
+
 // tagsDataSourceCRUDInterceptor implements transparent tagging on CRUD operations for data sources.
 type tagsDataSourceCRUDInterceptor struct {
 	interceptors.HTags
@@ -238,7 +283,7 @@ func (r tagsDataSourceCRUDInterceptor) run(ctx context.Context, opts crudInterce
 	return diags
 }
 
-func setTagsAll() customizeDiffInterceptor {
+func setTagsAll(tagsAllMode tftags.TagsAllMode) customizeDiffInterceptor {
 	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
 		c := opts.c
 
@@ -248,6 +293,10 @@ func setTagsAll() customizeDiffInterceptor {
 			case CustomizeDiff:
 				// Calculate the new value for the `tags_all` attribute.
 				if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+					// Callers that manage `tags_all` themselves plan its value.
+					if tagsAllMode == tftags.TagsAllCallerManaged {
+						return nil
+					}
 					if err := d.SetNewComputed(names.AttrTagsAll); err != nil {
 						return fmt.Errorf("setting tags_all to Computed: %w", err)
 					}
@@ -256,6 +305,17 @@ func setTagsAll() customizeDiffInterceptor {
 
 				newTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(newTags).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+				if sp, _, _, _, _, ok := interceptors.InfoFromContext(ctx, c); ok {
+					if summary, detail, ok := interceptors.CaseInsensitiveDuplicateTags(sp.ServicePackageName(), c.DefaultTagsConfig(ctx), newTags); ok {
+						return fmt.Errorf("%s: %s", summary, detail)
+					}
+					if summary, detail, ok := interceptors.TagLimitExceeded(sp.ServicePackageName(), allTags); ok {
+						return fmt.Errorf("%s: %s", summary, detail)
+					}
+				}
+				if tagsAllMode == tftags.TagsAllCallerManaged {
+					return nil
+				}
 				if d.HasChange(names.AttrTags) {
 					if newTags.HasZeroValue() {
 						if err := d.SetNewComputed(names.AttrTagsAll); err != nil {
@@ -335,27 +395,37 @@ func validateRequiredTags() customizeDiffInterceptor {
 
 				cfgTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))
 				allTags := c.DefaultTagsConfig(ctx).MergeTags(cfgTags)
-				if allTags.ContainsAllKeys(reqTags) {
+				disallowed := policy.DisallowedTagValues(typeName, allTags)
+				if allTags.ContainsAllKeys(reqTags) && len(disallowed) == 0 {
 					return nil
 				}
 
-				missing := reqTags.Removed(allTags).Keys()
-				slices.Sort(missing)
-				summary := "Missing Required Tags"
-				detail := fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing)
-
-				// CustomizeDiff does not support diagnostics (only an error return)
-				switch policy.Severity {
-				case "warning":
-					// Warning diagnostics are only logged
-					tflog.Warn(ctx, "Required Tags Validation", map[string]any{
-						"summary": summary,
-						"detail":  detail,
-					})
-				default:
-					// Error diagnostics merge summary and detail into a single message
-					return fmt.Errorf("%s - %s", summary, detail)
+				var errs []error
+				report := func(summary, detail string) {
+					// CustomizeDiff does not support diagnostics (only an error return)
+					switch policy.Severity {
+					case "warning":
+						// Warning diagnostics are only logged
+						tflog.Warn(ctx, "Required Tags Validation", map[string]any{
+							"summary": summary,
+							"detail":  detail,
+						})
+					default:
+						// Error diagnostics merge summary and detail into a single message
+						errs = append(errs, fmt.Errorf("%s - %s", summary, detail))
+					}
 				}
+
+				if !allTags.ContainsAllKeys(reqTags) {
+					missing := reqTags.Removed(allTags).Keys()
+					slices.Sort(missing)
+					report("Missing Required Tags", fmt.Sprintf("An organizational tag policy requires the following tags for %s: %s", typeName, missing))
+				}
+				if len(disallowed) > 0 {
+					report("Disallowed Tag Values", fmt.Sprintf("An organizational tag policy does not allow the values of the following tags for %s: %s", typeName, disallowed))
+				}
+
+				return errors.Join(errs...)
 			}
 		}
 
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"time"
+)
+
+// StateChangeProgress describes a state transition observed while waiting for a state change.
+type StateChangeProgress struct {
+	PreviousState string        // The previously observed state, empty for the first observed state
+	State         string        // The observed state
+	Elapsed       time.Duration // Time since the wait started
+	Remaining     time.Duration // Time remaining before the wait times out
+	Status        any           // The raw result of the refresh that observed the state
+}
+
+// ProgressFunc receives the state transitions observed while waiting for a state change.
+// It is called synchronously from the waiter and should return quickly.
+type ProgressFunc func(context.Context, StateChangeProgress)
+
+type progressContextKeyType int
+
+var progressContextKey progressContextKeyType
+
+// NewProgressContext returns a copy of the specified context on which waiters publish their progress to the specified function.
+func NewProgressContext(ctx context.Context, f ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressContextKey, f)
+}
+
+func progressFromContext(ctx context.Context) ProgressFunc {
+	f, _ := ctx.Value(progressContextKey).(ProgressFunc)
+	return f
+}
@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWaitForState_progress(t *testing.T) {
+	t.Parallel()
+
+	states := []string{"creating", "creating", "modifying", "available"}
+	var n int
+	var got []StateChangeProgress
+	ctx := clock.NewContext(t.Context(), clock.NewVirtual(time.Now()))
+	ctx = NewProgressContext(ctx, func(_ context.Context, p StateChangeProgress) {
+		got = append(got, p)
+	})
+
+	conf := &StateChangeConf{
+		Pending: []string{"creating", "modifying"},
+		Target:  []string{"available"},
+		Refresh: func(context.Context) (any, string, error) {
+			state := states[n]
+			n++
+			return n, state, nil
+		},
+		Timeout:      1 * time.Hour,
+		PollInterval: 1 * time.Minute,
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []StateChangeProgress{
+		{
+			State:     "creating",
+			Remaining: 60 * time.Minute,
+			Status:    1,
+		},
+		{
+			PreviousState: "creating",
+			State:         "modifying",
+			Elapsed:       2 * time.Minute,
+			Remaining:     58 * time.Minute,
+			Status:        3,
+		},
+		{
+			PreviousState: "modifying",
+			State:         "available",
+			Elapsed:       3 * time.Minute,
+			Remaining:     57 * time.Minute,
+			Status:        4,
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
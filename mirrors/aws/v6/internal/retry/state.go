@@ -10,11 +10,9 @@ import (
 	"time"
 
 	"github.com/blampe/patches/mirrors/aws/v6/internal/backoff"
-	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
 	tfslices "github.com/blampe/patches/mirrors/aws/v6/internal/slices"
 	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
-	"github.com/blampe/patches/mirrors/aws/v6/internal/vcr"
-	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
 //
@@ -68,8 +66,12 @@ type StateChangeConf = StateChangeConfOf[any, string]
 //
 // Cancellation of the passed in context will cancel the refresh loop.
 //
-// When VCR testing is enabled in replay mode, the DelayFunc is overridden to
-// allow interactions to be replayed with no delay between state change refreshes.
+// Each observed state transition is published to any ProgressFunc installed on the passed in
+// context with NewProgressContext.
+//
+// Delays between refreshes are measured using the clock carried by the passed in context.
+// When VCR testing is enabled in replay mode, that is a virtual clock that allows
+// interactions to be replayed with no delay between state change refreshes.
 func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T, error) {
 	// Set a default for times to check for not found.
 	if conf.NotFoundChecks == 0 {
@@ -82,18 +84,16 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 	// Set a default Delay using the StateChangeConf values
 	delay := backoff.SDKv2HelperRetryCompatibleDelay(conf.Delay, conf.PollInterval, conf.MinTimeout)
 
-	// When VCR testing in replay mode, override the default Delay
-	if inContext, ok := conns.FromContext(ctx); ok && inContext.VCREnabled() {
-		if mode, _ := vcr.Mode(); mode == recorder.ModeReplayOnly {
-			delay = backoff.ZeroDelay
-		}
-	}
+	progress := progressFromContext(ctx)
+	clk := clock.FromContext(ctx)
+	start := clk.Now()
 
 	var (
 		t                             T
-		currentState                  S
+		currentState, previousState   S
 		err                           error
 		notFoundTick, targetOccurence int
+		observed                      bool
 		l                             *backoff.Loop
 	)
 	for l = backoff.NewLoopWithOptions(conf.Timeout, backoff.WithDelay(delay)); l.Continue(ctx); {
@@ -107,6 +107,17 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 			return t, err
 		}
 
+		if progress != nil && (!observed || currentState != previousState) {
+			progress(ctx, StateChangeProgress{
+				PreviousState: string(previousState),
+				State:         string(currentState),
+				Elapsed:       clk.Now().Sub(start),
+				Remaining:     l.Remaining(),
+				Status:        t,
+			})
+		}
+		observed, previousState = true, currentState
+
 		if inttypes.IsZero(t) {
 			// If we're waiting for the absence of a thing, then return.
 			if len(conf.Target) == 0 {
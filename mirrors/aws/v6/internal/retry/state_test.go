@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -195,6 +196,40 @@ func TestWaitForState_timeout(t *testing.T) {
 	}
 }
 
+func TestWaitForState_virtualClock(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	ctx := clock.NewContext(t.Context(), clock.NewVirtual(start))
+
+	var n atomic.Int32
+	conf := &StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"running"},
+		Refresh: func(context.Context) (any, string, error) {
+			n.Add(1)
+			return &value{val: "value"}, "pending", nil
+		},
+		Timeout:      1 * time.Hour,
+		PollInterval: 1 * time.Minute,
+	}
+
+	_, err := conf.WaitForStateContext(ctx)
+
+	if !TimedOut(err) {
+		t.Fatalf("Expected timeout error, got: %s", err)
+	}
+
+	// Refreshes at 0m, 1m, ..., 60m and once more in the grace period.
+	if got, want := n.Load(), int32(62); got != want {
+		t.Errorf("Refreshes = %d, want %d", got, want)
+	}
+
+	if elapsed := time.Since(start); elapsed > 1*time.Minute {
+		t.Errorf("Wall time elapsed = %v", elapsed)
+	}
+}
+
 func TestWaitForState_success(t *testing.T) {
 	t.Parallel()
 
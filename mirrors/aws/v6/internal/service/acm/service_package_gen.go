@@ -96,7 +96,6 @@ func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (
 			}
 		},
 		withExtraOptions(ctx, p, config),
-		p.pulumiCustomizeRetries(cfg),
 	}
 
 	return acm.NewFromConfig(cfg, optFns...), nil
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package acm
+
+import (
+	"context"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+)
+
+var _ conns.ServicePackageWithRetryOverrides = (*servicePackage)(nil)
+
+func (p *servicePackage) RetryOverrides(context.Context) []conns.RetryOverride {
+	return []conns.RetryOverride{
+		// Don't retry once a certificate quota, e.g. the yearly quota, is reached. It won't reset for days, if at all.
+		{ErrorCode: "LimitExceededException"},
+	}
+}
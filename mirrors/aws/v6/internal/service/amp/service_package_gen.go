@@ -38,12 +38,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_prometheus_query_logging_configuration",
 			Name:     "QueryLoggingConfiguration",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newResourcePolicyResource,
 			TypeName: "aws_prometheus_resource_policy",
 			Name:     "Resource Policy",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newScraperResource,
@@ -53,12 +59,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				IdentifierAttribute: names.AttrARN,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newWorkspaceConfigurationResource,
 			TypeName: "aws_prometheus_workspace_configuration",
 			Name:     "WorkspaceConfiguration",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 	}
 }
@@ -38,6 +38,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_api_gateway_account",
 			Name:     "Account",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newDomainNameAccessAssociationResource,
@@ -50,6 +53,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Identity: inttypes.RegionalARNIdentity(inttypes.WithIdentityDuplicateAttrs(names.AttrID)),
 			Import: inttypes.FrameworkImport{
 				WrappedImport: true,
+				ImportState:   true,
 			},
 		},
 		{
@@ -57,6 +61,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_api_gateway_rest_api_put",
 			Name:     "Rest API Put",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 	}
 }
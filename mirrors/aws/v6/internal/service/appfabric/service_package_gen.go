@@ -34,6 +34,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				IdentifierAttribute: names.AttrARN,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newAppAuthorizationConnectionResource,
@@ -52,6 +55,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Identity: inttypes.RegionalARNIdentity(inttypes.WithIdentityDuplicateAttrs(names.AttrID)),
 			Import: inttypes.FrameworkImport{
 				WrappedImport: true,
+				ImportState:   true,
 			},
 		},
 		{
@@ -62,6 +66,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				IdentifierAttribute: names.AttrARN,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newIngestionDestinationResource,
@@ -71,6 +78,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				IdentifierAttribute: names.AttrARN,
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 	}
 }
@@ -41,6 +41,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_apprunner_default_auto_scaling_configuration_version",
 			Name:     "Default AutoScaling Configuration Version",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newDeploymentResource,
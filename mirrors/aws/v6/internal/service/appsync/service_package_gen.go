@@ -34,6 +34,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				IdentifierAttribute: "api_arn",
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newChannelNamespaceResource,
@@ -43,12 +46,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				IdentifierAttribute: "channel_namespace_arn",
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newSourceAPIAssociationResource,
 			TypeName: "aws_appsync_source_api_association",
 			Name:     "Source API Association",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 	}
 }
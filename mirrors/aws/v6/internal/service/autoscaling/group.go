@@ -8,6 +8,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -36,6 +37,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/blampe/patches/mirrors/aws/v6/internal/flex"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/sdkv2/types/nullable"
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/tfresource"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/verify"
 	"github.com/blampe/patches/mirrors/aws/v6/names"
@@ -154,6 +156,11 @@ func resourceGroup() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"default_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"desired_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -1012,6 +1019,7 @@ func resourceGroup() *schema.Resource {
 			launchTemplateCustomDiff(names.AttrLaunchTemplate, "launch_template.0.name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.launch_template_specification.0.launch_template_name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.override"),
+			groupDefaultTagsCustomDiff,
 		),
 	}
 }
@@ -1030,6 +1038,20 @@ func instanceMaintenancePolicyDiffSupress(k, old, new string, d *schema.Resource
 	return false
 }
 
+// groupDefaultTagsCustomDiff plans the provider default tags applied to an Auto Scaling Group.
+func groupDefaultTagsCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+	if !diff.NewValueKnown("tag") {
+		return diff.SetNewComputed("default_tags")
+	}
+
+	defaultTags := groupDefaultTags(ctx, meta.(*conns.AWSClient), keyValueTags(ctx, diff.Get("tag"), diff.Id(), TagResourceTypeGroup))
+	if defaultTags.Equal(tftags.New(ctx, diff.Get("default_tags"))) {
+		return nil
+	}
+
+	return diff.SetNew("default_tags", defaultTags.Map())
+}
+
 func launchTemplateCustomDiff(baseAttribute, subAttribute string) schema.CustomizeDiffFunc {
 	return func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
 		if diff.HasChange(subAttribute) {
@@ -1197,8 +1219,10 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		inputCASG.ServiceLinkedRoleARN = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tag"); ok {
-		inputCASG.Tags = svcTags(keyValueTags(ctx, v, asgName, TagResourceTypeGroup).IgnoreAWS())
+	c := meta.(*conns.AWSClient)
+	tags := keyValueTags(ctx, d.Get("tag"), asgName, TagResourceTypeGroup)
+	if tags = withGroupDefaultTags(ctx, c, tags, groupDefaultTags(ctx, c, tags), asgName); len(tags) > 0 {
+		inputCASG.Tags = svcTags(tags.IgnoreAWS())
 	}
 
 	if v, ok := d.GetOk("target_group_arns"); ok && len(v.(*schema.Set).List()) > 0 {
@@ -1413,7 +1437,19 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 	d.Set("warm_pool_size", g.WarmPoolSize)
 
-	if err := d.Set("tag", listOfMap(keyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig))); err != nil {
+	tags := keyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	// Provider default tags that aren't configured in a tag block are reported in default_tags.
+	defaultTags := make(map[string]string)
+	for k, v := range groupDefaultTags(ctx, meta.(*conns.AWSClient), keyValueTags(ctx, d.Get("tag"), d.Id(), TagResourceTypeGroup)).Map() {
+		if tv := tags.KeyValue(k); tv != nil && aws.ToString(tv) == v {
+			defaultTags[k] = v
+		}
+	}
+	tags = tags.Ignore(tftags.New(ctx, defaultTags))
+	if err := d.Set("default_tags", defaultTags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting default_tags: %s", err)
+	}
+	if err := d.Set("tag", listOfMap(tags)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tag: %s", err)
 	}
 
@@ -1430,6 +1466,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	var shouldRefreshInstances bool
 
 	if d.HasChangesExcept(
+		"default_tags",
 		"enabled_metrics",
 		"load_balancers",
 		"suspended_processes",
@@ -1573,12 +1610,15 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
-	if d.HasChanges("tag") {
+	if d.HasChanges("default_tags", "tag") {
+		c := meta.(*conns.AWSClient)
 		oTagRaw, nTagRaw := d.GetChange("tag")
-		oldTags := svcTags(keyValueTags(ctx, oTagRaw, d.Id(), TagResourceTypeGroup))
-		newTags := svcTags(keyValueTags(ctx, nTagRaw, d.Id(), TagResourceTypeGroup))
+		oDefaultTagsRaw, _ := d.GetChange("default_tags")
+		oldTags := withGroupDefaultTags(ctx, c, keyValueTags(ctx, oTagRaw, d.Id(), TagResourceTypeGroup), tftags.New(ctx, oDefaultTagsRaw), d.Id())
+		newTags := keyValueTags(ctx, nTagRaw, d.Id(), TagResourceTypeGroup)
+		newTags = withGroupDefaultTags(ctx, c, newTags, groupDefaultTags(ctx, c, newTags), d.Id())
 
-		if err := updateTags(ctx, conn, d.Id(), TagResourceTypeGroup, oldTags, newTags); err != nil {
+		if err := updateTags(ctx, conn, d.Id(), TagResourceTypeGroup, svcTags(oldTags), svcTags(newTags)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating tags for Auto Scaling Group (%s): %s", d.Id(), err)
 		}
 	}
@@ -4207,3 +4247,36 @@ func validateGroupInstanceRefreshTriggerFields(i any, path cty.Path) diag.Diagno
 
 	return sdkdiag.AppendErrorf(diags, "'%s' is not a recognized parameter name for aws_autoscaling_group", v)
 }
+
+// groupDefaultTags returns the provider default tags applied to an Auto Scaling Group with the specified configured tags,
+// i.e. those whose keys aren't configured in any of the group's tag blocks.
+func groupDefaultTags(ctx context.Context, c *conns.AWSClient, tags tftags.KeyValueTags) tftags.KeyValueTags {
+	return c.DefaultTagsConfig(ctx).GetTags().Ignore(tags).IgnoreAWS().IgnoreConfig(c.IgnoreTagsConfig(ctx))
+}
+
+// withGroupDefaultTags returns an Auto Scaling Group's configured tags together with the specified default tags.
+// Default tags are propagated at launch as set in the provider's default_tags configuration.
+func withGroupDefaultTags(ctx context.Context, c *conns.AWSClient, tags, defaultTags tftags.KeyValueTags, identifier string) tftags.KeyValueTags {
+	var propagateAtLaunch bool
+	if v := c.DefaultTagsConfig(ctx); v != nil {
+		propagateAtLaunch = v.PropagateAtLaunch
+	}
+
+	resourceType := TagResourceTypeGroup
+	m := make(map[string]*tftags.TagData, len(tags)+len(defaultTags))
+	for k, v := range defaultTags.Map() {
+		m[k] = &tftags.TagData{
+			Value: aws.String(v),
+			AdditionalBoolFields: map[string]*bool{
+				"PropagateAtLaunch": aws.Bool(propagateAtLaunch),
+			},
+			AdditionalStringFields: map[string]*string{
+				"ResourceId":   aws.String(identifier),
+				"ResourceType": aws.String(resourceType),
+			},
+		}
+	}
+	maps.Copy(m, tags)
+
+	return tftags.New(ctx, m)
+}
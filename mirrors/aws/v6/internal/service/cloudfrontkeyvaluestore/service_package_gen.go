@@ -37,6 +37,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			}),
 			Import: inttypes.FrameworkImport{
 				WrappedImport: true,
+				ImportState:   true,
 				ImportID:      securityGroupVPCAssociationImportID{},
 				SetIDAttr:     true,
 			},
@@ -46,6 +47,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_cloudfrontkeyvaluestore_keys_exclusive",
 			Name:     "Keys  Exclusive",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 	}
 }
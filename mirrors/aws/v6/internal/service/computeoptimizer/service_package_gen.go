@@ -31,12 +31,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_computeoptimizer_enrollment_status",
 			Name:     "Enrollment Status",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newRecommendationPreferencesResource,
 			TypeName: "aws_computeoptimizer_recommendation_preferences",
 			Name:     "Recommendation Preferences",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 	}
 }
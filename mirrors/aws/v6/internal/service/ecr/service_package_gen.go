@@ -61,12 +61,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_ecr_account_setting",
 			Name:     "Account Setting",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newPullTimeUpdateExclusionResource,
 			TypeName: "aws_ecr_pull_time_update_exclusion",
 			Name:     "Pull Time Update Exclusion",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 	}
 }
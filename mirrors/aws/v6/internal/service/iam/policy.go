@@ -37,6 +37,7 @@ const (
 
 // @SDKResource("aws_iam_policy", name="Policy")
 // @Tags(identifierAttribute="arn", resourceType="Policy")
+// @ExistenceGuard("policyExistenceGuard")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Policy")
 // @ArnIdentity
 // @Testing(preIdentityVersion="v6.4.0")
@@ -109,6 +110,27 @@ func resourcePolicy() *schema.Resource {
 	}
 }
 
+// policyExistenceGuard prevents a policy created outside Terraform from being reported as a generic CreatePolicy conflict.
+type policyExistenceGuard struct{}
+
+func (policyExistenceGuard) ExpectedID(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+	// A generated name is unique.
+	name := d.Get(names.AttrName).(string)
+	if name == "" {
+		return "", nil
+	}
+
+	return meta.(*conns.AWSClient).GlobalARN(ctx, "iam", "policy"+d.Get(names.AttrPath).(string)+name), nil
+}
+
+func (policyExistenceGuard) Find(ctx context.Context, id string, meta any) error {
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	_, err := findPolicyByARN(ctx, conn, id)
+
+	return err
+}
+
 func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
@@ -49,6 +49,7 @@ const (
 // @Tags(identifierAttribute="name", resourceType="Role")
 // @IdentityAttribute("name")
 // @CustomImport
+// @ExistenceGuard("roleExistenceGuard")
 // @V60SDKv2Fix
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Role")
 // @Testing(idAttrDuplicates="name")
@@ -205,6 +206,22 @@ func resourceRole() *schema.Resource {
 	}
 }
 
+// roleExistenceGuard prevents a role created outside Terraform from being reported as a generic CreateRole conflict.
+type roleExistenceGuard struct{}
+
+func (roleExistenceGuard) ExpectedID(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+	// A generated name is unique and isn't known until Create, so it's returned empty.
+	return d.Get(names.AttrName).(string), nil
+}
+
+func (roleExistenceGuard) Find(ctx context.Context, id string, meta any) error {
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	_, err := findRoleByName(ctx, conn, id)
+
+	return err
+}
+
 func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+)
+
+var _ conns.ServicePackageWithLogRedactions = (*servicePackage)(nil)
+
+func (p *servicePackage) LogRedactions(context.Context) []conns.LogRedaction {
+	return []conns.LogRedaction{
+		{Operation: "Decrypt", ResponseFields: []string{"Plaintext"}},
+		{Operation: "Encrypt", RequestFields: []string{"Plaintext"}},
+		{Operation: "GenerateDataKey", ResponseFields: []string{"Plaintext"}},
+		{Operation: "GenerateRandom", ResponseFields: []string{"Plaintext"}},
+		{Operation: "ImportKeyMaterial", RequestFields: []string{"EncryptedKeyMaterial"}},
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+)
+
+var _ conns.ServicePackageWithLogRedactions = (*servicePackage)(nil)
+
+func (p *servicePackage) LogRedactions(context.Context) []conns.LogRedaction {
+	return []conns.LogRedaction{
+		// Function code archives can be many megabytes and bloat memory when logged.
+		{Operation: "CreateFunction", RequestBody: true},
+		{Operation: "UpdateFunctionCode", RequestBody: true},
+	}
+}
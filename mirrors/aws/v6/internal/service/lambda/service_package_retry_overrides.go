@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+)
+
+var _ conns.ServicePackageWithRetryOverrides = (*servicePackage)(nil)
+
+func (p *servicePackage) RetryOverrides(context.Context) []conns.RetryOverride {
+	return []conns.RetryOverride{
+		// Don't retry environment variable decryption failures. See https://github.com/pulumi/pulumi-aws/issues/3196.
+		{ErrorCode: "KMSAccessDeniedException", ErrorMessage: "Lambda was unable to decrypt the environment variables because KMS access was denied."},
+	}
+}
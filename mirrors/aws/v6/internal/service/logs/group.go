@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
 // @Tags(identifierAttribute="arn")
+// @ExistenceGuard("groupExistenceGuard")
 // @IdentityAttribute("name")
 // @Testing(destroyTakesT=true)
 // @Testing(existsTakesT=true)
@@ -106,6 +107,22 @@ func resourceGroup() *schema.Resource {
 	}
 }
 
+// groupExistenceGuard prevents a log group created outside Terraform from being reported as a generic CreateLogGroup conflict.
+type groupExistenceGuard struct{}
+
+func (groupExistenceGuard) ExpectedID(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+	// A generated name is unique and isn't known until Create, so it's returned empty.
+	return d.Get(names.AttrName).(string), nil
+}
+
+func (groupExistenceGuard) Find(ctx context.Context, id string, meta any) error {
+	conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+	_, err := findLogGroupByName(ctx, conn, id)
+
+	return err
+}
+
 func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LogsClient(ctx)
@@ -129,23 +129,35 @@ func updateTags(ctx context.Context, conn *route53.Client, identifier, resourceT
 		return nil
 	}
 
-	input := route53.ChangeTagsForResourceInput{
-		ResourceId:   aws.String(identifier),
-		ResourceType: awstypes.TagResourceType(resourceType),
-	}
+	// Stay under the per-call tag limit by sending removed and updated tags in chunks.
+	removedChunks, updatedChunks := removedTags.Chunks(10), updatedTags.Chunks(10)
+	for i := range max(len(removedChunks), len(updatedChunks)) {
+		var removedTags, updatedTags tftags.KeyValueTags
+		if i < len(removedChunks) {
+			removedTags = removedChunks[i]
+		}
+		if i < len(updatedChunks) {
+			updatedTags = updatedChunks[i]
+		}
 
-	if len(updatedTags) > 0 {
-		input.AddTags = svcTags(updatedTags)
-	}
+		input := route53.ChangeTagsForResourceInput{
+			ResourceId:   aws.String(identifier),
+			ResourceType: awstypes.TagResourceType(resourceType),
+		}
 
-	if len(removedTags) > 0 {
-		input.RemoveTagKeys = removedTags.Keys()
-	}
+		if len(updatedTags) > 0 {
+			input.AddTags = svcTags(updatedTags)
+		}
 
-	_, err := conn.ChangeTagsForResource(ctx, &input, optFns...)
+		if len(removedTags) > 0 {
+			input.RemoveTagKeys = removedTags.Keys()
+		}
 
-	if err != nil {
-		return smarterr.NewError(err)
+		_, err := conn.ChangeTagsForResource(ctx, &input, optFns...)
+
+		if err != nil {
+			return smarterr.NewError(err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package route53resolver
+
+import (
+	"context"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+)
+
+var _ conns.ServicePackageWithRetryOverrides = (*servicePackage)(nil)
+
+func (p *servicePackage) RetryOverrides(context.Context) []conns.RetryOverride {
+	return []conns.RetryOverride{
+		// Resolver quotas aren't transient.
+		{ErrorCode: "LimitExceededException"},
+	}
+}
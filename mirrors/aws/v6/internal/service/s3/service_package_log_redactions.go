@@ -0,0 +1,20 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+)
+
+var _ conns.ServicePackageWithLogRedactions = (*servicePackage)(nil)
+
+func (p *servicePackage) LogRedactions(context.Context) []conns.LogRedaction {
+	return []conns.LogRedaction{
+		// Object contents can be arbitrarily large and may be sensitive.
+		{Operation: "PutObject", RequestBody: true},
+		{Operation: "UploadPart", RequestBody: true},
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+)
+
+var _ conns.ServicePackageWithLogRedactions = (*servicePackage)(nil)
+
+func (p *servicePackage) LogRedactions(context.Context) []conns.LogRedaction {
+	return []conns.LogRedaction{
+		{Operation: "CreateSecret", RequestFields: []string{"SecretBinary", "SecretString"}},
+		{Operation: "GetSecretValue", ResponseFields: []string{"SecretBinary", "SecretString"}},
+		{Operation: "PutSecretValue", RequestFields: []string{"SecretBinary", "SecretString"}},
+		{Operation: "UpdateSecret", RequestFields: []string{"SecretBinary", "SecretString"}},
+	}
+}
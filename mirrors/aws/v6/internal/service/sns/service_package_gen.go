@@ -81,6 +81,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
 			},
+			ExistenceGuard: topicExistenceGuard{},
 		},
 		{
 			Factory:  resourceTopicDataProtectionPolicy,
@@ -10,7 +10,6 @@ import (
 	"log"
 	"regexp"
 	"strconv"
-	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -217,6 +216,7 @@ var (
 
 // @SDKResource("aws_sns_topic", name="Topic")
 // @Tags(identifierAttribute="arn")
+// @ExistenceGuard("topicExistenceGuard")
 // @ArnIdentity
 // @Testing(preIdentityVersion="v6.4.0")
 // @Testing(existsType="map[string]string")
@@ -233,11 +233,25 @@ func resourceTopic() *schema.Resource {
 	}
 }
 
-func constructTopicArn(client *sns.Client, account, region, partition, snsTopicName string) string {
-	return fmt.Sprintf("arn:%s:sns:%s:%s:%s", partition, region, account, snsTopicName)
+// topicExistenceGuard prevents CreateTopic, which is idempotent, from adopting an existing topic.
+type topicExistenceGuard struct{}
+
+func (topicExistenceGuard) ExpectedID(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+	// A generated name is unique.
+	if d.Get(names.AttrName).(string) == "" {
+		return "", nil
+	}
+
+	return meta.(*conns.AWSClient).RegionalARN(ctx, "sns", topicName(d)), nil
 }
 
-var snsGlobalMutex sync.Map
+func (topicExistenceGuard) Find(ctx context.Context, id string, meta any) error {
+	conn := meta.(*conns.AWSClient).SNSClient(ctx)
+
+	_, err := findTopicAttributesByARN(ctx, conn, id)
+
+	return err
+}
 
 func resourceTopicCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -267,28 +281,6 @@ func resourceTopicCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		delete(attributes, topicAttributeNameFIFOThroughputScope)
 	}
 
-	// create a lock based on the topic ARN. We really want to make sure
-	// that we prevent a race condition where two resources are created with
-	// the same name.
-	awsClient := meta.(*conns.AWSClient)
-	topicArn := constructTopicArn(conn, awsClient.AccountID(ctx), awsClient.Region(ctx), awsClient.Partition(ctx), name)
-	localMutex := &sync.Mutex{}
-	if val, ok := snsGlobalMutex.LoadOrStore(topicArn, localMutex); ok {
-		localMutex = val.(*sync.Mutex)
-	}
-	localMutex.Lock()
-	defer localMutex.Unlock()
-
-	// Look up if the topic already exists
-	_, err = findTopicAttributesWithValidAWSPrincipalsByARN(ctx, conn, topicArn)
-
-	if err == nil {
-		return diag.Errorf("SNS Topic (%s) already exists", name)
-	}
-	if !tfresource.NotFound(err) {
-		return diag.FromErr(err)
-	}
-
 	output, err := conn.CreateTopic(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
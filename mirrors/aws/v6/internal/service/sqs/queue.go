@@ -195,6 +195,7 @@ var (
 
 // @SDKResource("aws_sqs_queue", name="Queue")
 // @Tags(identifierAttribute="id")
+// @ExistenceGuard("queueExistenceGuard")
 // @IdentityVersion(1)
 // @CustomInherentRegionIdentity("url", "parseQueueURL")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sqs/types;awstypes;map[awstypes.QueueAttributeName]string")
@@ -220,6 +221,26 @@ func resourceQueue() *schema.Resource {
 	}
 }
 
+// queueExistenceGuard prevents CreateQueue, which returns an existing queue with matching attributes, from adopting it.
+type queueExistenceGuard struct{}
+
+func (queueExistenceGuard) ExpectedID(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+	// A generated name is unique.
+	if d.Get(names.AttrName).(string) == "" {
+		return "", nil
+	}
+
+	return queueName(d), nil
+}
+
+func (queueExistenceGuard) Find(ctx context.Context, id string, meta any) error {
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	_, err := findQueueURLByName(ctx, conn, id)
+
+	return err
+}
+
 func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SQSClient(ctx)
@@ -67,6 +67,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
 			},
+			ExistenceGuard: queueExistenceGuard{},
 		},
 		{
 			Factory:  resourceQueuePolicy,
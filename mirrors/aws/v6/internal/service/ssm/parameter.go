@@ -32,6 +32,7 @@ import (
 
 // @SDKResource("aws_ssm_parameter", name="Parameter")
 // @Tags(identifierAttribute="id", resourceType="Parameter")
+// @ExistenceGuard("parameterExistenceGuard")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ssm/types;awstypes;awstypes.Parameter")
 // @Testing(importIgnore="has_value_wo")
 // @IdentityAttribute("name")
@@ -181,6 +182,26 @@ func resourceParameter() *schema.Resource {
 	}
 }
 
+// parameterExistenceGuard prevents PutParameter with overwrite from adopting an existing parameter.
+type parameterExistenceGuard struct{}
+
+func (parameterExistenceGuard) ExpectedID(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+	// An explicit overwrite opts in to adopting the existing parameter.
+	if shouldUpdateParameter(d) {
+		return "", nil
+	}
+
+	return d.Get(names.AttrName).(string), nil
+}
+
+func (parameterExistenceGuard) Find(ctx context.Context, id string, meta any) error {
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	_, err := findParameterByName(ctx, conn, id, false)
+
+	return err
+}
+
 func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMClient(ctx)
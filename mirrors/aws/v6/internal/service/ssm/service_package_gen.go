@@ -187,6 +187,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Import: inttypes.SDKv2Import{
 				CustomImport: true,
 			},
+			ExistenceGuard: parameterExistenceGuard{},
 		},
 		{
 			Factory:  resourcePatchBaseline,
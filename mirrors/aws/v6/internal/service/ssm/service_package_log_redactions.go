@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+)
+
+var _ conns.ServicePackageWithLogRedactions = (*servicePackage)(nil)
+
+func (p *servicePackage) LogRedactions(context.Context) []conns.LogRedaction {
+	return []conns.LogRedaction{
+		{Operation: "GetParameter", ResponseFields: []string{"Parameter.Value"}},
+		{Operation: "GetParameterHistory", ResponseFields: []string{"Parameters.Value"}},
+		{Operation: "GetParameters", ResponseFields: []string{"Parameters.Value"}},
+		{Operation: "GetParametersByPath", ResponseFields: []string{"Parameters.Value"}},
+		{Operation: "PutParameter", RequestFields: []string{"Value"}},
+	}
+}
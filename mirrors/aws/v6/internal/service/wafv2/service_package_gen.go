@@ -31,12 +31,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			TypeName: "aws_wafv2_api_key",
 			Name:     "API Key",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 		{
 			Factory:  newResourceWebACLRuleGroupAssociation,
 			TypeName: "aws_wafv2_web_acl_rule_group_association",
 			Name:     "Web ACL Rule Group Association",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
 		},
 	}
 }
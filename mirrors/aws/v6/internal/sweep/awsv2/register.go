@@ -12,7 +12,15 @@ import (
 	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/internal/log"
 )
 
+// Register registers a sweeper with both the test framework's `-sweep` support and the sweeper registry
+// used by the standalone reaper command.
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
+	sweep.RegisterSweeper(sweep.Sweeper{
+		Name:         name,
+		F:            f,
+		Dependencies: dependencies,
+	})
+
 	resource.AddTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
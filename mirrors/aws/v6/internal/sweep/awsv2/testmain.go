@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsv2
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+	_ "unsafe" // Required for go:linkname
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/internal/schedule"
+)
+
+const defaultSweepParallelism = 10
+
+// The test framework's sweeper registry, which includes the sweepers registered directly with resource.AddTestSweepers.
+// The test framework has no way of listing them.
+//
+//go:linkname sweeperFuncs github.com/hashicorp/terraform-plugin-testing/helper/resource.sweeperFuncs
+var sweeperFuncs map[string]*resource.Sweeper
+
+// TestMain is a replacement for resource.TestMain.
+// When the `-sweep` flag is set, the registered sweepers are run with the test framework's `-sweep-run` and `-sweep-allow-failures` flags,
+// but sweepers that don't depend on each other run concurrently, up to `-sweep-parallelism` at once.
+// A failed sweeper stops the sweepers that depend on it from running and, unless failures are allowed, stops any more sweepers from starting.
+func TestMain(m interface {
+	Run() int
+}) {
+	parallelism := flag.Int("sweep-parallelism", defaultSweepParallelism, "Maximum number of Sweepers to run concurrently")
+	flag.Parse()
+
+	regions := flag.Lookup("sweep").Value.String()
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	filter := flag.Lookup("sweep-run").Value.String()
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+
+	var failed bool
+	for region := range strings.SplitSeq(regions, ",") {
+		region = strings.TrimSpace(region)
+
+		if err := runSweepers(region, filter, schedule.Options{Parallelism: *parallelism, FailFast: !allowFailures}); err != nil {
+			log.Printf("[ERROR] Sweepers for region (%s) failed: %s", region, err)
+			failed = true
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func runSweepers(region, filter string, opts schedule.Options) error {
+	ctx := sweep.Context(region)
+
+	// Create the shared client before any sweepers run, as the client cache isn't safe for concurrent writes.
+	if _, err := sweep.SharedRegionalSweepClient(ctx, region); err != nil {
+		return err
+	}
+
+	dependencies := make(map[string][]string, len(sweeperFuncs))
+	for name, s := range sweeperFuncs {
+		dependencies[name] = s.Dependencies
+	}
+	selected := selectSweepers(filter, dependencies)
+
+	start := time.Now()
+	log.Printf("[DEBUG] Running Sweepers for region (%s):\n", region)
+	results, err := schedule.Run(ctx, dependencies, func(name string) bool {
+		return selected[name]
+	}, opts, func(_ context.Context, name string) error {
+		log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+		start := time.Now()
+		err := sweeperFuncs[name].F(region)
+
+		log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+		if err != nil {
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+	var errs []error
+	log.Printf("Sweeper Tests for region (%s) ran successfully:\n", region)
+	for _, result := range results {
+		if result.Err == nil {
+			log.Printf("\t- %s\n", result.Name)
+		} else {
+			errs = append(errs, result.Err)
+		}
+	}
+
+	if len(errs) > 0 {
+		log.Printf("Sweeper Tests for region (%s) ran unsuccessfully:\n", region)
+		for _, result := range results {
+			if result.Err != nil {
+				log.Printf("\t- %s: %s\n", result.Name, result.Err)
+			}
+		}
+
+		return errors.New("at least one sweeper failed")
+	}
+
+	return nil
+}
+
+// selectSweepers returns the names of the sweepers selected by the test framework's `-sweep-run` flag, and of the sweepers they depend on.
+// Like the test framework, a sweeper is selected if its name contains any of the comma-separated values, ignoring case.
+func selectSweepers(filter string, dependencies map[string][]string) map[string]bool {
+	selected := make(map[string]bool)
+
+	var include func(string)
+	include = func(name string) {
+		if selected[name] {
+			return
+		}
+		selected[name] = true
+		for _, dependency := range dependencies[name] {
+			include(dependency)
+		}
+	}
+
+	filters := strings.Split(strings.ToLower(filter), ",")
+	for name := range dependencies {
+		if filter == "" {
+			include(name)
+			continue
+		}
+		for _, f := range filters {
+			if strings.Contains(strings.ToLower(name), f) {
+				include(name)
+			}
+		}
+	}
+
+	return selected
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package awsv2
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestSelectSweepers(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_vpc":            {"aws_subnet"},
+		"aws_subnet":         {"aws_instance"},
+		"aws_instance":       nil,
+		"aws_sqs_queue":      nil,
+		"aws_sns_topic":      nil,
+		"aws_sns_topic_rule": nil,
+	}
+
+	testCases := []struct {
+		filter string
+		want   []string
+	}{
+		{
+			filter: "",
+			want:   slices.Sorted(maps.Keys(dependencies)),
+		},
+		{
+			filter: "aws_subnet",
+			want:   []string{"aws_instance", "aws_subnet"},
+		},
+		{
+			filter: "AWS_VPC,sqs",
+			want:   []string{"aws_instance", "aws_sqs_queue", "aws_subnet", "aws_vpc"},
+		},
+		{
+			filter: "sns_topic",
+			want:   []string{"aws_sns_topic", "aws_sns_topic_rule"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.filter, func(t *testing.T) {
+			t.Parallel()
+
+			if got := slices.Sorted(maps.Keys(selectSweepers(testCase.filter, dependencies))); !slices.Equal(got, testCase.want) {
+				t.Errorf("selectSweepers(%q) = %v, want %v", testCase.filter, got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate go run ../../../generate/servicepackages/main.go -ServicePackageRoot ../../../service -- service_packages_gen.go
+//go:generate go run ../../../generate/sweeperregistration/main.go -ServicePackageRoot ../../../service -- register_gen.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package main
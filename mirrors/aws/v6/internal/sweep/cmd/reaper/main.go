@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// The reaper command deletes leaked resources, such as those left behind by failed acceptance tests,
+// using the registered sweepers and the provider's own delete logic.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/reaper"
+)
+
+var (
+	regions       = flag.String("regions", "", "Comma-separated list of AWS Regions to reap")
+	resourceTypes = flag.String("type", "", "Regular expression selecting the resource types to reap, e.g. `^aws_sqs_`")
+	name          = flag.String("name", "", "Regular expression selecting resources by name or, for resources without a name, ID")
+	olderThan     = flag.Duration("older-than", 0, "Select resources created more than this long ago, e.g. `24h`")
+	dependencies  = flag.Bool("dependencies", false, "Also reap the resource types that the selected resource types depend on")
+	dryRun        = flag.Bool("dry-run", true, "Report the resources that would be deleted without deleting them")
+	parallelism   = flag.Int("parallelism", 10, "Maximum number of sweepers to run concurrently")
+	reportFile    = flag.String("report", "", "Write the JSON report to this file instead of standard output")
+	tags          = make(tagFlag)
+)
+
+func init() {
+	flag.Var(tags, "tag", "Select resources with the tag `key=value`, or with the tag key and any value; may be repeated")
+}
+
+// tagFlag collects repeated -tag flags.
+type tagFlag map[string]string
+
+func (f tagFlag) String() string {
+	var s []string
+	for k, v := range f {
+		s = append(s, k+"="+v)
+	}
+	return strings.Join(s, ",")
+}
+
+func (f tagFlag) Set(s string) error {
+	k, v, _ := strings.Cut(s, "=")
+	if k == "" {
+		return fmt.Errorf("invalid tag %q, expected key=value", s)
+	}
+	f[k] = v
+	return nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\treaper -regions <regions> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Resources are only deleted with -dry-run=false.\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *regions == "" || flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	opts := reaper.Options{
+		Filter: reaper.Filter{
+			Tags:      tags,
+			OlderThan: *olderThan,
+		},
+		DryRun:       *dryRun,
+		Dependencies: *dependencies,
+		Parallelism:  *parallelism,
+	}
+	for _, v := range []struct {
+		flag string
+		expr string
+		re   **regexp.Regexp
+	}{
+		{flag: "type", expr: *resourceTypes, re: &opts.Filter.ResourceTypes},
+		{flag: "name", expr: *name, re: &opts.Filter.Name},
+	} {
+		if v.expr == "" {
+			continue
+		}
+		re, err := regexp.Compile(v.expr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid -%s: %s\n", v.flag, err)
+			os.Exit(2)
+		}
+		*v.re = re
+	}
+
+	sweep.ServicePackages = servicePackages(context.Background())
+	registerSweepers()
+
+	var reports []*reaper.Report
+	var failures int
+	for region := range strings.SplitSeq(*regions, ",") {
+		ctx := sweep.Context(region)
+
+		report, err := run(ctx, region, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "reaping %s: %s\n", region, err)
+			os.Exit(1)
+		}
+
+		printSummary(report)
+		reports = append(reports, report)
+		failures += report.Errors()
+	}
+
+	if err := writeReport(reports); err != nil {
+		fmt.Fprintf(os.Stderr, "writing report: %s\n", err)
+		os.Exit(1)
+	}
+
+	if failures > 0 {
+		fmt.Fprintf(os.Stderr, "%d failures\n", failures)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, region string, opts reaper.Options) (*reaper.Report, error) {
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("getting client: %w", err)
+	}
+
+	return reaper.Run(ctx, region, client, sweep.Sweepers(), opts)
+}
+
+// printSummary prints a line for each selected resource to standard error.
+func printSummary(report *reaper.Report) {
+	for _, s := range report.Sweepers {
+		if s.Error != "" {
+			fmt.Fprintf(os.Stderr, "%s\t%s\tlisting failed: %s\n", report.Region, s.ResourceType, s.Error)
+		}
+		for _, v := range s.Resources {
+			line := fmt.Sprintf("%s\t%s\t%s\t%s", report.Region, s.ResourceType, v.Action, v.ID)
+			if v.Name != "" && v.Name != v.ID {
+				line += fmt.Sprintf(" (%s)", v.Name)
+			}
+			if v.Error != "" {
+				line += ": " + v.Error
+			}
+			fmt.Fprintln(os.Stderr, line)
+		}
+	}
+}
+
+func writeReport(reports []*reaper.Report) error {
+	out := os.Stdout
+	if *reportFile != "" {
+		f, err := os.Create(*reportFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(reports)
+}
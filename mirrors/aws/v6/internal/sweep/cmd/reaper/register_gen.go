@@ -0,0 +1,378 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/sweeperregistration/main.go; DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/accessanalyzer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/acm"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/acmpca"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/amp"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/amplify"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/apigateway"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/apigatewayv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appautoscaling"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appconfig"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appfabric"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appflow"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/applicationinsights"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appmesh"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/apprunner"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appstream"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appsync"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/athena"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/auditmanager"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/autoscaling"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/autoscalingplans"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/backup"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/batch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/bcmdataexports"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/bedrockagent"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/bedrockagentcore"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/billing"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/budgets"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/chime"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cleanrooms"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloud9"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudformation"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudfront"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudhsmv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudtrail"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudwatch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codeartifact"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codebuild"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codegurureviewer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codepipeline"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codestarconnections"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codestarnotifications"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cognitoidentity"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cognitoidp"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/configservice"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/connect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cur"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/customerprofiles"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dataexchange"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/datasync"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/datazone"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dax"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/deploy"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/devicefarm"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/directconnect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dlm"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dms"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/docdb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/docdbelastic"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ds"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dsql"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dynamodb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ec2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ecr"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ecrpublic"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ecs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/efs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/eks"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elasticache"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elasticbeanstalk"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elasticsearch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elbv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/emr"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/emrcontainers"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/emrserverless"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/events"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/evidently"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/evs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/finspace"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/firehose"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/fis"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/fms"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/fsx"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/gamelift"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/glacier"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/globalaccelerator"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/glue"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/grafana"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/guardduty"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/iam"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/imagebuilder"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/inspector"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/internetmonitor"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/iot"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kafka"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kafkaconnect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kendra"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/keyspaces"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kinesis"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kinesisanalytics"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kinesisanalyticsv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kms"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lakeformation"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lambda"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lexmodels"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lexv2models"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/licensemanager"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lightsail"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/location"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/logs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/m2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/medialive"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mediapackage"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/memorydb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mq"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mwaa"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/neptune"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/neptunegraph"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/networkfirewall"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/networkflowmonitor"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/networkmanager"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/notifications"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/notificationscontacts"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/opensearch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/opensearchserverless"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/organizations"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/osis"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pinpoint"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pinpointsmsvoicev2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pipes"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/qbusiness"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/qldb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/quicksight"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ram"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/rds"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/redshift"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/redshiftserverless"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/resiliencehub"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/resourceexplorer2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/resourcegroups"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53profiles"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53recoverycontrolconfig"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53resolver"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/rum"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3control"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3tables"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3vectors"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sagemaker"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/scheduler"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/schemas"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/secretsmanager"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/securitylake"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/servicecatalog"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/servicecatalogappregistry"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/servicediscovery"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ses"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sesv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sfn"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/shield"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/signer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sqs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssm"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssmcontacts"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssmincidents"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssmquicksetup"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssoadmin"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/storagegateway"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/swf"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/synthetics"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/timestreaminfluxdb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/timestreamwrite"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/transcribe"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/transfer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/verifiedpermissions"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/vpclattice"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/waf"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/wafregional"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/wafv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/workspaces"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/xray"
+)
+
+func registerSweepers() {
+	accessanalyzer.RegisterSweepers()
+	acm.RegisterSweepers()
+	acmpca.RegisterSweepers()
+	amp.RegisterSweepers()
+	amplify.RegisterSweepers()
+	apigateway.RegisterSweepers()
+	apigatewayv2.RegisterSweepers()
+	appautoscaling.RegisterSweepers()
+	appconfig.RegisterSweepers()
+	appfabric.RegisterSweepers()
+	appflow.RegisterSweepers()
+	applicationinsights.RegisterSweepers()
+	appmesh.RegisterSweepers()
+	apprunner.RegisterSweepers()
+	appstream.RegisterSweepers()
+	appsync.RegisterSweepers()
+	athena.RegisterSweepers()
+	auditmanager.RegisterSweepers()
+	autoscaling.RegisterSweepers()
+	autoscalingplans.RegisterSweepers()
+	backup.RegisterSweepers()
+	batch.RegisterSweepers()
+	bcmdataexports.RegisterSweepers()
+	bedrockagent.RegisterSweepers()
+	bedrockagentcore.RegisterSweepers()
+	billing.RegisterSweepers()
+	budgets.RegisterSweepers()
+	chime.RegisterSweepers()
+	cleanrooms.RegisterSweepers()
+	cloud9.RegisterSweepers()
+	cloudformation.RegisterSweepers()
+	cloudfront.RegisterSweepers()
+	cloudhsmv2.RegisterSweepers()
+	cloudtrail.RegisterSweepers()
+	cloudwatch.RegisterSweepers()
+	codeartifact.RegisterSweepers()
+	codebuild.RegisterSweepers()
+	codegurureviewer.RegisterSweepers()
+	codepipeline.RegisterSweepers()
+	codestarconnections.RegisterSweepers()
+	codestarnotifications.RegisterSweepers()
+	cognitoidentity.RegisterSweepers()
+	cognitoidp.RegisterSweepers()
+	configservice.RegisterSweepers()
+	connect.RegisterSweepers()
+	cur.RegisterSweepers()
+	customerprofiles.RegisterSweepers()
+	dataexchange.RegisterSweepers()
+	datasync.RegisterSweepers()
+	datazone.RegisterSweepers()
+	dax.RegisterSweepers()
+	deploy.RegisterSweepers()
+	devicefarm.RegisterSweepers()
+	directconnect.RegisterSweepers()
+	dlm.RegisterSweepers()
+	dms.RegisterSweepers()
+	docdb.RegisterSweepers()
+	docdbelastic.RegisterSweepers()
+	ds.RegisterSweepers()
+	dsql.RegisterSweepers()
+	dynamodb.RegisterSweepers()
+	ec2.RegisterSweepers()
+	ecr.RegisterSweepers()
+	ecrpublic.RegisterSweepers()
+	ecs.RegisterSweepers()
+	efs.RegisterSweepers()
+	eks.RegisterSweepers()
+	elasticache.RegisterSweepers()
+	elasticbeanstalk.RegisterSweepers()
+	elasticsearch.RegisterSweepers()
+	elb.RegisterSweepers()
+	elbv2.RegisterSweepers()
+	emr.RegisterSweepers()
+	emrcontainers.RegisterSweepers()
+	emrserverless.RegisterSweepers()
+	events.RegisterSweepers()
+	evidently.RegisterSweepers()
+	evs.RegisterSweepers()
+	finspace.RegisterSweepers()
+	firehose.RegisterSweepers()
+	fis.RegisterSweepers()
+	fms.RegisterSweepers()
+	fsx.RegisterSweepers()
+	gamelift.RegisterSweepers()
+	glacier.RegisterSweepers()
+	globalaccelerator.RegisterSweepers()
+	glue.RegisterSweepers()
+	grafana.RegisterSweepers()
+	guardduty.RegisterSweepers()
+	iam.RegisterSweepers()
+	imagebuilder.RegisterSweepers()
+	inspector.RegisterSweepers()
+	internetmonitor.RegisterSweepers()
+	iot.RegisterSweepers()
+	kafka.RegisterSweepers()
+	kafkaconnect.RegisterSweepers()
+	kendra.RegisterSweepers()
+	keyspaces.RegisterSweepers()
+	kinesis.RegisterSweepers()
+	kinesisanalytics.RegisterSweepers()
+	kinesisanalyticsv2.RegisterSweepers()
+	kms.RegisterSweepers()
+	lakeformation.RegisterSweepers()
+	lambda.RegisterSweepers()
+	lexmodels.RegisterSweepers()
+	lexv2models.RegisterSweepers()
+	licensemanager.RegisterSweepers()
+	lightsail.RegisterSweepers()
+	location.RegisterSweepers()
+	logs.RegisterSweepers()
+	m2.RegisterSweepers()
+	medialive.RegisterSweepers()
+	mediapackage.RegisterSweepers()
+	memorydb.RegisterSweepers()
+	mq.RegisterSweepers()
+	mwaa.RegisterSweepers()
+	neptune.RegisterSweepers()
+	neptunegraph.RegisterSweepers()
+	networkfirewall.RegisterSweepers()
+	networkflowmonitor.RegisterSweepers()
+	networkmanager.RegisterSweepers()
+	notifications.RegisterSweepers()
+	notificationscontacts.RegisterSweepers()
+	opensearch.RegisterSweepers()
+	opensearchserverless.RegisterSweepers()
+	organizations.RegisterSweepers()
+	osis.RegisterSweepers()
+	pinpoint.RegisterSweepers()
+	pinpointsmsvoicev2.RegisterSweepers()
+	pipes.RegisterSweepers()
+	qbusiness.RegisterSweepers()
+	qldb.RegisterSweepers()
+	quicksight.RegisterSweepers()
+	ram.RegisterSweepers()
+	rds.RegisterSweepers()
+	redshift.RegisterSweepers()
+	redshiftserverless.RegisterSweepers()
+	resiliencehub.RegisterSweepers()
+	resourceexplorer2.RegisterSweepers()
+	resourcegroups.RegisterSweepers()
+	route53.RegisterSweepers()
+	route53profiles.RegisterSweepers()
+	route53recoverycontrolconfig.RegisterSweepers()
+	route53resolver.RegisterSweepers()
+	rum.RegisterSweepers()
+	s3.RegisterSweepers()
+	s3control.RegisterSweepers()
+	s3tables.RegisterSweepers()
+	s3vectors.RegisterSweepers()
+	sagemaker.RegisterSweepers()
+	scheduler.RegisterSweepers()
+	schemas.RegisterSweepers()
+	secretsmanager.RegisterSweepers()
+	securitylake.RegisterSweepers()
+	servicecatalog.RegisterSweepers()
+	servicecatalogappregistry.RegisterSweepers()
+	servicediscovery.RegisterSweepers()
+	ses.RegisterSweepers()
+	sesv2.RegisterSweepers()
+	sfn.RegisterSweepers()
+	shield.RegisterSweepers()
+	signer.RegisterSweepers()
+	sns.RegisterSweepers()
+	sqs.RegisterSweepers()
+	ssm.RegisterSweepers()
+	ssmcontacts.RegisterSweepers()
+	ssmincidents.RegisterSweepers()
+	ssmquicksetup.RegisterSweepers()
+	ssoadmin.RegisterSweepers()
+	storagegateway.RegisterSweepers()
+	swf.RegisterSweepers()
+	synthetics.RegisterSweepers()
+	timestreaminfluxdb.RegisterSweepers()
+	timestreamwrite.RegisterSweepers()
+	transcribe.RegisterSweepers()
+	transfer.RegisterSweepers()
+	verifiedpermissions.RegisterSweepers()
+	vpclattice.RegisterSweepers()
+	waf.RegisterSweepers()
+	wafregional.RegisterSweepers()
+	wafv2.RegisterSweepers()
+	workspaces.RegisterSweepers()
+	xray.RegisterSweepers()
+}
@@ -0,0 +1,540 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Code generated by internal/generate/servicepackages/main.go; DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+	"slices"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/accessanalyzer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/account"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/acm"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/acmpca"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/amp"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/amplify"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/apigateway"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/apigatewayv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appautoscaling"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appconfig"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appfabric"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appflow"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appintegrations"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/applicationinsights"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/applicationsignals"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appmesh"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/apprunner"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appstream"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/appsync"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/arcregionswitch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/arczonalshift"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/athena"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/auditmanager"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/autoscaling"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/autoscalingplans"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/backup"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/batch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/bcmdataexports"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/bedrock"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/bedrockagent"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/bedrockagentcore"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/billing"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/budgets"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ce"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/chatbot"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/chime"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/chimesdkmediapipelines"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/chimesdkvoice"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cleanrooms"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloud9"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudcontrol"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudformation"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudfront"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudfrontkeyvaluestore"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudhsmv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudsearch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudtrail"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cloudwatch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codeartifact"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codebuild"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codecatalyst"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codecommit"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codeconnections"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codeguruprofiler"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codegurureviewer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codepipeline"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codestarconnections"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/codestarnotifications"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cognitoidentity"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cognitoidp"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/comprehend"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/computeoptimizer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/configservice"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/connect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/connectcases"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/controltower"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/costoptimizationhub"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/cur"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/customerprofiles"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/databrew"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dataexchange"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/datapipeline"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/datasync"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/datazone"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dax"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/deploy"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/detective"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/devicefarm"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/devopsguru"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/directconnect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dlm"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dms"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/docdb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/docdbelastic"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/drs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ds"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dsql"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/dynamodb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ec2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ecr"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ecrpublic"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ecs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/efs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/eks"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elasticache"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elasticbeanstalk"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elasticsearch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elastictranscoder"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/elbv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/emr"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/emrcontainers"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/emrserverless"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/events"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/evidently"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/evs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/finspace"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/firehose"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/fis"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/fms"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/fsx"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/gamelift"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/glacier"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/globalaccelerator"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/glue"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/grafana"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/greengrass"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/groundstation"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/guardduty"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/healthlake"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/iam"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/identitystore"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/imagebuilder"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/inspector"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/inspector2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/internetmonitor"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/invoicing"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/iot"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ivs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ivschat"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kafka"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kafkaconnect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kendra"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/keyspaces"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kinesis"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kinesisanalytics"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kinesisanalyticsv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kinesisvideo"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/kms"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lakeformation"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lambda"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/launchwizard"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lexmodels"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lexv2models"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/licensemanager"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/lightsail"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/location"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/logs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/m2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/macie2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mediaconnect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mediaconvert"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/medialive"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mediapackage"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mediapackagev2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mediapackagevod"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mediastore"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/memorydb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/meta"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mgn"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mq"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mwaa"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/mwaaserverless"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/neptune"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/neptunegraph"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/networkfirewall"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/networkflowmonitor"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/networkmanager"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/networkmonitor"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/notifications"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/notificationscontacts"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/oam"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/observabilityadmin"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/odb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/opensearch"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/opensearchserverless"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/organizations"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/osis"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/outposts"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/paymentcryptography"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pcaconnectorad"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pcs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pinpoint"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pinpointsmsvoicev2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pipes"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/polly"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/pricing"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/qbusiness"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/qldb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/quicksight"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ram"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/rbin"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/rds"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/rdsdata"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/redshift"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/redshiftdata"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/redshiftserverless"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/rekognition"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/resiliencehub"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/resourceexplorer2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/resourcegroups"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/resourcegroupstaggingapi"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/rolesanywhere"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53domains"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53profiles"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53recoverycontrolconfig"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53recoveryreadiness"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/route53resolver"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/rum"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3control"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3outposts"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3tables"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/s3vectors"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sagemaker"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/scheduler"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/schemas"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/secretsmanager"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/securityhub"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/securitylake"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/serverlessrepo"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/servicecatalog"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/servicecatalogappregistry"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/servicediscovery"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/servicequotas"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ses"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sesv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sfn"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/shield"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/signer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sqs"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssm"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssmcontacts"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssmincidents"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssmquicksetup"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssmsap"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sso"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/ssoadmin"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/storagegateway"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/sts"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/swf"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/synthetics"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/taxsettings"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/timestreaminfluxdb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/timestreamquery"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/timestreamwrite"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/transcribe"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/transfer"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/verifiedpermissions"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/vpclattice"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/waf"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/wafregional"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/wafv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/wellarchitected"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/workmail"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/workspaces"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/workspacesweb"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/service/xray"
+)
+
+func servicePackages(ctx context.Context) []conns.ServicePackage {
+	v := []conns.ServicePackage{
+		accessanalyzer.ServicePackage(ctx),
+		account.ServicePackage(ctx),
+		acm.ServicePackage(ctx),
+		acmpca.ServicePackage(ctx),
+		amp.ServicePackage(ctx),
+		amplify.ServicePackage(ctx),
+		apigateway.ServicePackage(ctx),
+		apigatewayv2.ServicePackage(ctx),
+		appautoscaling.ServicePackage(ctx),
+		appconfig.ServicePackage(ctx),
+		appfabric.ServicePackage(ctx),
+		appflow.ServicePackage(ctx),
+		appintegrations.ServicePackage(ctx),
+		applicationinsights.ServicePackage(ctx),
+		applicationsignals.ServicePackage(ctx),
+		appmesh.ServicePackage(ctx),
+		apprunner.ServicePackage(ctx),
+		appstream.ServicePackage(ctx),
+		appsync.ServicePackage(ctx),
+		arcregionswitch.ServicePackage(ctx),
+		arczonalshift.ServicePackage(ctx),
+		athena.ServicePackage(ctx),
+		auditmanager.ServicePackage(ctx),
+		autoscaling.ServicePackage(ctx),
+		autoscalingplans.ServicePackage(ctx),
+		backup.ServicePackage(ctx),
+		batch.ServicePackage(ctx),
+		bcmdataexports.ServicePackage(ctx),
+		bedrock.ServicePackage(ctx),
+		bedrockagent.ServicePackage(ctx),
+		bedrockagentcore.ServicePackage(ctx),
+		billing.ServicePackage(ctx),
+		budgets.ServicePackage(ctx),
+		ce.ServicePackage(ctx),
+		chatbot.ServicePackage(ctx),
+		chime.ServicePackage(ctx),
+		chimesdkmediapipelines.ServicePackage(ctx),
+		chimesdkvoice.ServicePackage(ctx),
+		cleanrooms.ServicePackage(ctx),
+		cloud9.ServicePackage(ctx),
+		cloudcontrol.ServicePackage(ctx),
+		cloudformation.ServicePackage(ctx),
+		cloudfront.ServicePackage(ctx),
+		cloudfrontkeyvaluestore.ServicePackage(ctx),
+		cloudhsmv2.ServicePackage(ctx),
+		cloudsearch.ServicePackage(ctx),
+		cloudtrail.ServicePackage(ctx),
+		cloudwatch.ServicePackage(ctx),
+		codeartifact.ServicePackage(ctx),
+		codebuild.ServicePackage(ctx),
+		codecatalyst.ServicePackage(ctx),
+		codecommit.ServicePackage(ctx),
+		codeconnections.ServicePackage(ctx),
+		codeguruprofiler.ServicePackage(ctx),
+		codegurureviewer.ServicePackage(ctx),
+		codepipeline.ServicePackage(ctx),
+		codestarconnections.ServicePackage(ctx),
+		codestarnotifications.ServicePackage(ctx),
+		cognitoidentity.ServicePackage(ctx),
+		cognitoidp.ServicePackage(ctx),
+		comprehend.ServicePackage(ctx),
+		computeoptimizer.ServicePackage(ctx),
+		configservice.ServicePackage(ctx),
+		connect.ServicePackage(ctx),
+		connectcases.ServicePackage(ctx),
+		controltower.ServicePackage(ctx),
+		costoptimizationhub.ServicePackage(ctx),
+		cur.ServicePackage(ctx),
+		customerprofiles.ServicePackage(ctx),
+		databrew.ServicePackage(ctx),
+		dataexchange.ServicePackage(ctx),
+		datapipeline.ServicePackage(ctx),
+		datasync.ServicePackage(ctx),
+		datazone.ServicePackage(ctx),
+		dax.ServicePackage(ctx),
+		deploy.ServicePackage(ctx),
+		detective.ServicePackage(ctx),
+		devicefarm.ServicePackage(ctx),
+		devopsguru.ServicePackage(ctx),
+		directconnect.ServicePackage(ctx),
+		dlm.ServicePackage(ctx),
+		dms.ServicePackage(ctx),
+		docdb.ServicePackage(ctx),
+		docdbelastic.ServicePackage(ctx),
+		drs.ServicePackage(ctx),
+		ds.ServicePackage(ctx),
+		dsql.ServicePackage(ctx),
+		dynamodb.ServicePackage(ctx),
+		ec2.ServicePackage(ctx),
+		ecr.ServicePackage(ctx),
+		ecrpublic.ServicePackage(ctx),
+		ecs.ServicePackage(ctx),
+		efs.ServicePackage(ctx),
+		eks.ServicePackage(ctx),
+		elasticache.ServicePackage(ctx),
+		elasticbeanstalk.ServicePackage(ctx),
+		elasticsearch.ServicePackage(ctx),
+		elastictranscoder.ServicePackage(ctx),
+		elb.ServicePackage(ctx),
+		elbv2.ServicePackage(ctx),
+		emr.ServicePackage(ctx),
+		emrcontainers.ServicePackage(ctx),
+		emrserverless.ServicePackage(ctx),
+		events.ServicePackage(ctx),
+		evidently.ServicePackage(ctx),
+		evs.ServicePackage(ctx),
+		finspace.ServicePackage(ctx),
+		firehose.ServicePackage(ctx),
+		fis.ServicePackage(ctx),
+		fms.ServicePackage(ctx),
+		fsx.ServicePackage(ctx),
+		gamelift.ServicePackage(ctx),
+		glacier.ServicePackage(ctx),
+		globalaccelerator.ServicePackage(ctx),
+		glue.ServicePackage(ctx),
+		grafana.ServicePackage(ctx),
+		greengrass.ServicePackage(ctx),
+		groundstation.ServicePackage(ctx),
+		guardduty.ServicePackage(ctx),
+		healthlake.ServicePackage(ctx),
+		iam.ServicePackage(ctx),
+		identitystore.ServicePackage(ctx),
+		imagebuilder.ServicePackage(ctx),
+		inspector.ServicePackage(ctx),
+		inspector2.ServicePackage(ctx),
+		internetmonitor.ServicePackage(ctx),
+		invoicing.ServicePackage(ctx),
+		iot.ServicePackage(ctx),
+		ivs.ServicePackage(ctx),
+		ivschat.ServicePackage(ctx),
+		kafka.ServicePackage(ctx),
+		kafkaconnect.ServicePackage(ctx),
+		kendra.ServicePackage(ctx),
+		keyspaces.ServicePackage(ctx),
+		kinesis.ServicePackage(ctx),
+		kinesisanalytics.ServicePackage(ctx),
+		kinesisanalyticsv2.ServicePackage(ctx),
+		kinesisvideo.ServicePackage(ctx),
+		kms.ServicePackage(ctx),
+		lakeformation.ServicePackage(ctx),
+		lambda.ServicePackage(ctx),
+		launchwizard.ServicePackage(ctx),
+		lexmodels.ServicePackage(ctx),
+		lexv2models.ServicePackage(ctx),
+		licensemanager.ServicePackage(ctx),
+		lightsail.ServicePackage(ctx),
+		location.ServicePackage(ctx),
+		logs.ServicePackage(ctx),
+		m2.ServicePackage(ctx),
+		macie2.ServicePackage(ctx),
+		mediaconnect.ServicePackage(ctx),
+		mediaconvert.ServicePackage(ctx),
+		medialive.ServicePackage(ctx),
+		mediapackage.ServicePackage(ctx),
+		mediapackagev2.ServicePackage(ctx),
+		mediapackagevod.ServicePackage(ctx),
+		mediastore.ServicePackage(ctx),
+		memorydb.ServicePackage(ctx),
+		meta.ServicePackage(ctx),
+		mgn.ServicePackage(ctx),
+		mq.ServicePackage(ctx),
+		mwaa.ServicePackage(ctx),
+		mwaaserverless.ServicePackage(ctx),
+		neptune.ServicePackage(ctx),
+		neptunegraph.ServicePackage(ctx),
+		networkfirewall.ServicePackage(ctx),
+		networkflowmonitor.ServicePackage(ctx),
+		networkmanager.ServicePackage(ctx),
+		networkmonitor.ServicePackage(ctx),
+		notifications.ServicePackage(ctx),
+		notificationscontacts.ServicePackage(ctx),
+		oam.ServicePackage(ctx),
+		observabilityadmin.ServicePackage(ctx),
+		odb.ServicePackage(ctx),
+		opensearch.ServicePackage(ctx),
+		opensearchserverless.ServicePackage(ctx),
+		organizations.ServicePackage(ctx),
+		osis.ServicePackage(ctx),
+		outposts.ServicePackage(ctx),
+		paymentcryptography.ServicePackage(ctx),
+		pcaconnectorad.ServicePackage(ctx),
+		pcs.ServicePackage(ctx),
+		pinpoint.ServicePackage(ctx),
+		pinpointsmsvoicev2.ServicePackage(ctx),
+		pipes.ServicePackage(ctx),
+		polly.ServicePackage(ctx),
+		pricing.ServicePackage(ctx),
+		qbusiness.ServicePackage(ctx),
+		qldb.ServicePackage(ctx),
+		quicksight.ServicePackage(ctx),
+		ram.ServicePackage(ctx),
+		rbin.ServicePackage(ctx),
+		rds.ServicePackage(ctx),
+		rdsdata.ServicePackage(ctx),
+		redshift.ServicePackage(ctx),
+		redshiftdata.ServicePackage(ctx),
+		redshiftserverless.ServicePackage(ctx),
+		rekognition.ServicePackage(ctx),
+		resiliencehub.ServicePackage(ctx),
+		resourceexplorer2.ServicePackage(ctx),
+		resourcegroups.ServicePackage(ctx),
+		resourcegroupstaggingapi.ServicePackage(ctx),
+		rolesanywhere.ServicePackage(ctx),
+		route53.ServicePackage(ctx),
+		route53domains.ServicePackage(ctx),
+		route53profiles.ServicePackage(ctx),
+		route53recoverycontrolconfig.ServicePackage(ctx),
+		route53recoveryreadiness.ServicePackage(ctx),
+		route53resolver.ServicePackage(ctx),
+		rum.ServicePackage(ctx),
+		s3.ServicePackage(ctx),
+		s3control.ServicePackage(ctx),
+		s3outposts.ServicePackage(ctx),
+		s3tables.ServicePackage(ctx),
+		s3vectors.ServicePackage(ctx),
+		sagemaker.ServicePackage(ctx),
+		scheduler.ServicePackage(ctx),
+		schemas.ServicePackage(ctx),
+		secretsmanager.ServicePackage(ctx),
+		securityhub.ServicePackage(ctx),
+		securitylake.ServicePackage(ctx),
+		serverlessrepo.ServicePackage(ctx),
+		servicecatalog.ServicePackage(ctx),
+		servicecatalogappregistry.ServicePackage(ctx),
+		servicediscovery.ServicePackage(ctx),
+		servicequotas.ServicePackage(ctx),
+		ses.ServicePackage(ctx),
+		sesv2.ServicePackage(ctx),
+		sfn.ServicePackage(ctx),
+		shield.ServicePackage(ctx),
+		signer.ServicePackage(ctx),
+		sns.ServicePackage(ctx),
+		sqs.ServicePackage(ctx),
+		ssm.ServicePackage(ctx),
+		ssmcontacts.ServicePackage(ctx),
+		ssmincidents.ServicePackage(ctx),
+		ssmquicksetup.ServicePackage(ctx),
+		ssmsap.ServicePackage(ctx),
+		sso.ServicePackage(ctx),
+		ssoadmin.ServicePackage(ctx),
+		storagegateway.ServicePackage(ctx),
+		sts.ServicePackage(ctx),
+		swf.ServicePackage(ctx),
+		synthetics.ServicePackage(ctx),
+		taxsettings.ServicePackage(ctx),
+		timestreaminfluxdb.ServicePackage(ctx),
+		timestreamquery.ServicePackage(ctx),
+		timestreamwrite.ServicePackage(ctx),
+		transcribe.ServicePackage(ctx),
+		transfer.ServicePackage(ctx),
+		verifiedpermissions.ServicePackage(ctx),
+		vpclattice.ServicePackage(ctx),
+		waf.ServicePackage(ctx),
+		wafregional.ServicePackage(ctx),
+		wafv2.ServicePackage(ctx),
+		wellarchitected.ServicePackage(ctx),
+		workmail.ServicePackage(ctx),
+		workspaces.ServicePackage(ctx),
+		workspacesweb.ServicePackage(ctx),
+		xray.ServicePackage(ctx),
+	}
+
+	return slices.Clone(v)
+}
@@ -4,18 +4,23 @@
 package framework
 
 import (
+	"cmp"
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/errs"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/errs/fwdiag"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/inspect"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/tfresource"
 	"github.com/blampe/patches/mirrors/aws/v6/names"
 )
@@ -47,32 +52,16 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 }
 
 func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.OptionsFunc) error {
-	resource, err := sr.factory(ctx)
+	resource, schema, err := sr.configure(ctx)
 	if err != nil {
 		return err
 	}
 
-	var configureResp fwresource.ConfigureResponse
-	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &configureResp)
-	if configureResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(configureResp.Diagnostics)
-	}
-
-	var schemaResp fwresource.SchemaResponse
-	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
-	if schemaResp.Diagnostics.HasError() {
-		return fwdiag.DiagnosticsError(schemaResp.Diagnostics)
-	}
-
-	state := tfsdk.State{
-		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
-		Schema: schemaResp.Schema,
+	state, err := sr.state(ctx, schema)
+	if err != nil {
+		return err
 	}
 	for _, attr := range sr.attributes {
-		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
-		}
 		switch v := attr.value.(type) {
 		case *string:
 			ctx = tflog.SetField(ctx, attr.path, aws.ToString(v))
@@ -87,22 +76,9 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	err = deleteResource(ctx, state, resource)
 
 	if errs.Contains(err, "Value Conversion Error") {
-		// Hack for per-resource Region override.
-		// Inject a top-level region attribute into the schema and retry.
-		schema := state.Schema.(rschema.Schema)
-		schema.Attributes[names.AttrRegion] = rschema.StringAttribute{
-			Optional: true,
-			Computed: true,
-		}
-		state := tfsdk.State{
-			Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
-			Schema: schema,
-		}
-		for _, attr := range sr.attributes {
-			d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
-			if d.HasError() {
-				return fwdiag.DiagnosticsError(d)
-			}
+		state, err = sr.state(ctx, withRegion(schema))
+		if err != nil {
+			return err
 		}
 
 		err = deleteResource(ctx, state, resource)
@@ -111,9 +87,139 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return err
 }
 
+// Inspect reads the resource and describes it.
+func (sr *sweepResource) Inspect(ctx context.Context) (inspect.Resource, error) {
+	resource, schema, err := sr.configure(ctx)
+	if err != nil {
+		return inspect.Resource{}, err
+	}
+
+	state, err := sr.state(ctx, schema)
+	if err != nil {
+		return inspect.Resource{}, err
+	}
+
+	state, err = readResource(ctx, state, resource)
+
+	if errs.Contains(err, "Value Conversion Error") {
+		state, err = sr.state(ctx, withRegion(schema))
+		if err != nil {
+			return inspect.Resource{}, err
+		}
+
+		state, err = readResource(ctx, state, resource)
+	}
+
+	if err != nil {
+		return inspect.Resource{}, err
+	}
+
+	if state.Raw.IsNull() {
+		return inspect.Resource{}, &retry.NotFoundError{}
+	}
+
+	getString := func(k string) string {
+		var v types.String
+		if diags := state.GetAttribute(ctx, path.Root(k), &v); diags.HasError() {
+			return ""
+		}
+		return v.ValueString()
+	}
+
+	v := inspect.Resource{
+		ID:   getString(names.AttrID),
+		Name: getString(names.AttrName),
+		ARN:  getString(names.AttrARN),
+	}
+	if v.ID == "" {
+		v.ID = cmp.Or(v.ARN, v.Name)
+	}
+	if v.ARN == "" && arn.IsARN(v.ID) {
+		v.ARN = v.ID
+	}
+
+	// Resources with transparent tagging don't read their tags themselves.
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		var m types.Map
+		if diags := state.GetAttribute(ctx, path.Root(k), &m); diags.HasError() || len(m.Elements()) == 0 {
+			continue
+		}
+		if diags := m.ElementsAs(ctx, &v.Tags, false); !diags.HasError() {
+			break
+		}
+	}
+
+	for _, k := range inspect.CreatedAtAttributes {
+		if t, ok := inspect.ParseTime(getString(k)); ok {
+			v.CreatedAt = t
+			break
+		}
+	}
+
+	return v, nil
+}
+
+// configure returns the configured resource and its schema.
+func (sr *sweepResource) configure(ctx context.Context) (fwresource.ResourceWithConfigure, rschema.Schema, error) {
+	resource, err := sr.factory(ctx)
+	if err != nil {
+		return nil, rschema.Schema{}, err
+	}
+
+	var configureResp fwresource.ConfigureResponse
+	resource.Configure(ctx, fwresource.ConfigureRequest{ProviderData: sr.meta}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return nil, rschema.Schema{}, fwdiag.DiagnosticsError(configureResp.Diagnostics)
+	}
+
+	var schemaResp fwresource.SchemaResponse
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return nil, rschema.Schema{}, fwdiag.DiagnosticsError(schemaResp.Diagnostics)
+	}
+
+	return resource, schemaResp.Schema, nil
+}
+
+// state returns a state with the sweep resource's attributes set.
+func (sr *sweepResource) state(ctx context.Context, schema rschema.Schema) (tfsdk.State, error) {
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
+	}
+	for _, attr := range sr.attributes {
+		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
+		if d.HasError() {
+			return tfsdk.State{}, fwdiag.DiagnosticsError(d)
+		}
+	}
+
+	return state, nil
+}
+
+// withRegion is a hack for per-resource Region override.
+// It injects a top-level region attribute into the schema.
+func withRegion(schema rschema.Schema) rschema.Schema {
+	schema.Attributes[names.AttrRegion] = rschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+	}
+
+	return schema
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
 
 	return fwdiag.DiagnosticsError(response.Diagnostics)
 }
+
+func readResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) (tfsdk.State, error) {
+	response := fwresource.ReadResponse{
+		State: state,
+	}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	return response.State, fwdiag.DiagnosticsError(response.Diagnostics)
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package inspect describes resources found by sweepers, so that they can be filtered before being deleted.
+package inspect
+
+import (
+	"context"
+	"time"
+
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+// Resource describes a resource found by a sweeper.
+type Resource struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name,omitempty"`
+	ARN       string            `json:"arn,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`      // Nil if the resource's tags weren't read.
+	CreatedAt time.Time         `json:"created_at,omitzero"` // Zero if the resource has no known creation time attribute.
+}
+
+// Inspectable is implemented by sweepable resources that can describe themselves.
+type Inspectable interface {
+	// Inspect reads the resource and describes it.
+	// A retry.NotFoundError is returned if the resource no longer exists.
+	Inspect(ctx context.Context) (Resource, error)
+}
+
+// CreatedAtAttributes are the names of the attributes, in order of preference,
+// that commonly hold a resource's creation time.
+var CreatedAtAttributes = []string{
+	names.AttrCreatedAt,
+	names.AttrCreatedDate,
+	names.AttrCreatedTime,
+	names.AttrCreationDate,
+	names.AttrCreationTime,
+	names.AttrCreateTime,
+	"create_date",
+	"launch_time",
+}
+
+// ParseTime parses a creation time attribute value.
+func ParseTime(s string) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schedule runs sweepers concurrently, each after the sweepers it depends on.
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/experimental/depgraph"
+)
+
+// Options configures Run.
+type Options struct {
+	// Parallelism is the maximum number of sweepers run at once. Values less than 1 mean 1.
+	Parallelism int
+	// FailFast stops starting sweepers after the first failure.
+	FailFast bool
+}
+
+// Result is the outcome of running a single sweeper.
+type Result struct {
+	Name string
+	Err  error
+}
+
+// ErrNotRun is the result of a sweeper that wasn't started because an earlier sweeper failed and Options.FailFast is set.
+var ErrNotRun = errors.New("not run after an earlier failure")
+
+// DependencyFailedError is the result of a sweeper that wasn't run because a sweeper it depends on, directly or indirectly, failed.
+type DependencyFailedError struct {
+	Dependency string
+}
+
+func (e *DependencyFailedError) Error() string {
+	return fmt.Sprintf("dependency (%s) failed", e.Dependency)
+}
+
+// Order returns the names of the selected sweepers, each after all of the sweepers it depends on, directly or indirectly.
+// dependencies maps the name of every sweeper to the names of the sweepers it depends on.
+func Order(dependencies map[string][]string, selected func(string) bool) ([]string, error) {
+	g, err := newGraph(dependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(order, func(name string) bool {
+		return !selected(name)
+	}), nil
+}
+
+// Run calls f for each of the selected sweepers once all of the sweepers it depends on have succeeded,
+// running up to Options.Parallelism sweepers at once.
+// A sweeper that isn't selected is treated as having succeeded without being run.
+// A failure only prevents the sweepers that depend on the failed sweeper from running; independent sweepers keep running.
+// The results are returned in the order given by Order.
+func Run(ctx context.Context, dependencies map[string][]string, selected func(string) bool, opts Options, f func(context.Context, string) error) ([]Result, error) {
+	g, err := newGraph(dependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	parallelism := max(opts.Parallelism, 1)
+	results := make(map[string]error, len(order))
+	remaining := make(map[string]int, len(order)) // Number of direct dependencies yet to complete.
+	var ready []string
+	for _, name := range order {
+		direct, _ := g.DirectDependenciesOf(name)
+		remaining[name] = len(direct)
+		if remaining[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	complete := func(name string, err error) {
+		results[name] = err
+
+		dependents, _ := g.DirectDependentsOf(name)
+		for _, dependent := range dependents {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	var stopped bool
+	// blocked returns why a ready sweeper must not be run, if it mustn't.
+	blocked := func(name string) error {
+		for _, dependency := range dependencies[name] {
+			switch err := results[dependency]; {
+			case err == nil:
+			case errors.Is(err, ErrNotRun), errors.As(err, new(*DependencyFailedError)), errors.Is(err, ctx.Err()):
+				return err
+			default:
+				return &DependencyFailedError{Dependency: dependency}
+			}
+		}
+
+		if stopped {
+			return ErrNotRun
+		}
+
+		return ctx.Err()
+	}
+
+	type completion struct {
+		name string
+		err  error
+	}
+	completions := make(chan completion)
+	var running int
+
+	for len(results) < len(order) {
+		for len(ready) > 0 {
+			name := ready[0]
+
+			if err := blocked(name); err != nil {
+				ready = ready[1:]
+				complete(name, err)
+				continue
+			}
+
+			if !selected(name) {
+				ready = ready[1:]
+				complete(name, nil)
+				continue
+			}
+
+			if running == parallelism {
+				break
+			}
+
+			ready = ready[1:]
+			running++
+			go func() {
+				completions <- completion{name: name, err: f(ctx, name)}
+			}()
+		}
+
+		if running == 0 {
+			break
+		}
+
+		c := <-completions
+		running--
+		if c.err != nil && opts.FailFast {
+			stopped = true
+		}
+		complete(c.name, c.err)
+	}
+
+	var result []Result
+	for _, name := range order {
+		if selected(name) {
+			result = append(result, Result{Name: name, Err: results[name]})
+		}
+	}
+
+	return result, nil
+}
+
+func newGraph(dependencies map[string][]string) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := slices.Sorted(maps.Keys(dependencies))
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dependency := range dependencies[name] {
+			if !g.HasNode(dependency) {
+				return nil, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func all(string) bool { return true }
+
+func TestOrder(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_a": {"aws_b"},
+		"aws_b": {"aws_c"},
+		"aws_c": nil,
+		"aws_d": nil,
+	}
+
+	got, err := Order(dependencies, func(name string) bool { return name != "aws_b" })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"aws_c", "aws_a", "aws_d"}; !slices.Equal(got, want) {
+		t.Errorf("Order() = %v, want %v", got, want)
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	// aws_a and aws_b both depend on aws_c, which fails. aws_d and aws_e are independent of it.
+	dependencies := map[string][]string{
+		"aws_a": {"aws_b"},
+		"aws_b": {"aws_c"},
+		"aws_c": nil,
+		"aws_d": {"aws_e"},
+		"aws_e": nil,
+	}
+	errFailed := errors.New("failed")
+
+	var mu sync.Mutex
+	var ran []string
+	results, err := Run(t.Context(), dependencies, all, Options{Parallelism: 2}, func(_ context.Context, name string) error {
+		mu.Lock()
+		ran = append(ran, name)
+		mu.Unlock()
+
+		if name == "aws_c" {
+			return errFailed
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slices.Sort(ran)
+	if want := []string{"aws_c", "aws_d", "aws_e"}; !slices.Equal(ran, want) {
+		t.Errorf("ran %v, want %v", ran, want)
+	}
+
+	want := map[string]error{
+		"aws_a": &DependencyFailedError{Dependency: "aws_c"},
+		"aws_b": &DependencyFailedError{Dependency: "aws_c"},
+		"aws_c": errFailed,
+		"aws_d": nil,
+		"aws_e": nil,
+	}
+	if got, want := len(results), len(want); got != want {
+		t.Fatalf("%d results, want %d", got, want)
+	}
+	for _, result := range results {
+		if got, want := result.Err, want[result.Name]; (got == nil) != (want == nil) || (got != nil && got.Error() != want.Error()) {
+			t.Errorf("%s: got error %v, want %v", result.Name, got, want)
+		}
+	}
+}
+
+func TestRunDependenciesFirst(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_a": {"aws_b", "aws_c"},
+		"aws_b": {"aws_d"},
+		"aws_c": {"aws_d"},
+		"aws_d": nil,
+		"aws_e": nil,
+		"aws_f": nil,
+	}
+	const parallelism = 2
+
+	var mu sync.Mutex
+	done := make(map[string]bool)
+	var running, maxRunning int
+	_, err := Run(t.Context(), dependencies, all, Options{Parallelism: parallelism}, func(_ context.Context, name string) error {
+		mu.Lock()
+		for _, dependency := range dependencies[name] {
+			if !done[dependency] {
+				t.Errorf("%s ran before its dependency %s", name, dependency)
+			}
+		}
+		running++
+		maxRunning = max(maxRunning, running)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond) // Give other sweepers the chance to start.
+
+		mu.Lock()
+		defer mu.Unlock()
+		running--
+		done[name] = true
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(done) != len(dependencies) {
+		t.Errorf("ran %d sweepers, want %d", len(done), len(dependencies))
+	}
+	if maxRunning != parallelism {
+		t.Errorf("%d sweepers ran at once, want %d", maxRunning, parallelism)
+	}
+}
+
+func TestRunNotSelected(t *testing.T) {
+	t.Parallel()
+
+	// aws_b isn't selected, but a failure of aws_c still prevents aws_a from running.
+	dependencies := map[string][]string{
+		"aws_a": {"aws_b"},
+		"aws_b": {"aws_c"},
+		"aws_c": nil,
+	}
+
+	results, err := Run(t.Context(), dependencies, func(name string) bool { return name != "aws_b" }, Options{}, func(_ context.Context, name string) error {
+		if name == "aws_b" {
+			t.Error("aws_b ran")
+		}
+		if name == "aws_c" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(results), 2; got != want {
+		t.Fatalf("%d results, want %d", got, want)
+	}
+	if got, want := results[1].Name, "aws_a"; got != want {
+		t.Fatalf("result name = %s, want %s", got, want)
+	}
+	if got := results[1].Err; !errors.As(got, new(*DependencyFailedError)) {
+		t.Errorf("aws_a error = %v, want DependencyFailedError", got)
+	}
+}
+
+func TestRunFailFast(t *testing.T) {
+	t.Parallel()
+
+	dependencies := map[string][]string{
+		"aws_a": nil,
+		"aws_b": nil,
+		"aws_c": nil,
+	}
+
+	results, err := Run(t.Context(), dependencies, all, Options{FailFast: true}, func(_ context.Context, name string) error {
+		if name == "aws_a" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, result := range results[1:] {
+		if !errors.Is(result.Err, ErrNotRun) {
+			t.Errorf("%s: got error %v, want %v", result.Name, result.Err, ErrNotRun)
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string][]string{
+		"missing dependency": {
+			"aws_a": {"aws_b"},
+		},
+		"cycle": {
+			"aws_a": {"aws_b"},
+			"aws_b": {"aws_a"},
+		},
+	}
+
+	for name, dependencies := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Run(t.Context(), dependencies, all, Options{}, func(context.Context, string) error { return nil }); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package reaper
+
+import (
+	"cmp"
+	"regexp"
+	"time"
+
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/inspect"
+)
+
+// Filter selects the resources to reap.
+// The zero value selects every resource found by every sweeper.
+type Filter struct {
+	// ResourceTypes selects the sweepers, by resource type, e.g. `^aws_sqs_`.
+	ResourceTypes *regexp.Regexp
+	// Name selects resources by name or, for resources without a name, ID.
+	Name *regexp.Regexp
+	// Tags selects resources with all of the tags. An empty value matches any value.
+	Tags map[string]string
+	// OlderThan selects resources created more than this long ago.
+	OlderThan time.Duration
+}
+
+// selectsResourceType returns whether the filter selects the sweeper for the specified resource type.
+func (f Filter) selectsResourceType(name string) bool {
+	return f.ResourceTypes == nil || f.ResourceTypes.MatchString(name)
+}
+
+// needsInspection returns whether resources must be described to be filtered.
+func (f Filter) needsInspection() bool {
+	return f.Name != nil || len(f.Tags) > 0 || f.OlderThan > 0
+}
+
+// Match returns whether the filter selects the described resource at the specified time.
+// A resource is never selected if a filter can't be evaluated, e.g. because its creation time isn't known.
+func (f Filter) Match(r inspect.Resource, now time.Time) bool {
+	if f.Name != nil && !f.Name.MatchString(cmp.Or(r.Name, r.ID)) {
+		return false
+	}
+
+	for k, want := range f.Tags {
+		got, ok := r.Tags[k]
+		if !ok || (want != "" && got != want) {
+			return false
+		}
+	}
+
+	if f.OlderThan > 0 && (r.CreatedAt.IsZero() || now.Sub(r.CreatedAt) < f.OlderThan) {
+		return false
+	}
+
+	return true
+}
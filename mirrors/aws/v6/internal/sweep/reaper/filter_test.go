@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package reaper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/inspect"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	resource := inspect.Resource{
+		ID:        "q-1",
+		Name:      "tf-acc-test-queue",
+		Tags:      map[string]string{"Owner": "ci", "Ephemeral": "true"},
+		CreatedAt: now.Add(-48 * time.Hour),
+	}
+
+	testCases := []struct {
+		name     string
+		filter   Filter
+		resource inspect.Resource
+		want     bool
+	}{
+		{
+			name:     "zero value",
+			resource: resource,
+			want:     true,
+		},
+		{
+			name:     "name",
+			filter:   Filter{Name: regexache.MustCompile(`^tf-acc-test-`)},
+			resource: resource,
+			want:     true,
+		},
+		{
+			name:     "name mismatch",
+			filter:   Filter{Name: regexache.MustCompile(`^prod-`)},
+			resource: resource,
+		},
+		{
+			name:     "name falls back to ID",
+			filter:   Filter{Name: regexache.MustCompile(`^q-`)},
+			resource: inspect.Resource{ID: "q-1"},
+			want:     true,
+		},
+		{
+			name:     "tag value",
+			filter:   Filter{Tags: map[string]string{"Owner": "ci"}},
+			resource: resource,
+			want:     true,
+		},
+		{
+			name:     "tag any value",
+			filter:   Filter{Tags: map[string]string{"Owner": "", "Ephemeral": "true"}},
+			resource: resource,
+			want:     true,
+		},
+		{
+			name:     "tag value mismatch",
+			filter:   Filter{Tags: map[string]string{"Owner": "dev"}},
+			resource: resource,
+		},
+		{
+			name:     "tags unknown",
+			filter:   Filter{Tags: map[string]string{"Owner": ""}},
+			resource: inspect.Resource{ID: "q-1"},
+		},
+		{
+			name:     "older",
+			filter:   Filter{OlderThan: 24 * time.Hour},
+			resource: resource,
+			want:     true,
+		},
+		{
+			name:     "newer",
+			filter:   Filter{OlderThan: 72 * time.Hour},
+			resource: resource,
+		},
+		{
+			name:     "creation time unknown",
+			filter:   Filter{OlderThan: time.Hour},
+			resource: inspect.Resource{ID: "q-1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.Match(testCase.resource, now), testCase.want; got != want {
+				t.Errorf("Match() = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package reaper deletes the resources found by registered sweepers that match a filter,
+// using the provider's own delete logic.
+package reaper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/awsv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/inspect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/internal/log"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/internal/schedule"
+)
+
+// Options configures Run.
+type Options struct {
+	Filter Filter
+	// DryRun reports the resources that would be deleted without deleting them.
+	DryRun bool
+	// Dependencies also runs the sweepers that the sweepers selected by resource type depend on.
+	// The other filters still apply to the resources they find.
+	Dependencies bool
+	// Parallelism is the maximum number of sweepers run at once.
+	// Sweepers that depend on each other are never run at the same time.
+	Parallelism int
+}
+
+// Action is what happened to a selected resource.
+type Action string
+
+const (
+	ActionDeleted     Action = "deleted"
+	ActionWouldDelete Action = "would_delete"
+	ActionFailed      Action = "failed"
+)
+
+// Report describes a run against a single Region.
+type Report struct {
+	Region   string          `json:"region"`
+	DryRun   bool            `json:"dry_run"`
+	Sweepers []SweeperReport `json:"sweepers"`
+}
+
+// SweeperReport describes the resources found by a single sweeper.
+type SweeperReport struct {
+	ResourceType string `json:"resource_type"`
+	// Skipped is why the sweeper was skipped, e.g. the service isn't available in the Region
+	// or a sweeper that it depends on failed.
+	Skipped string `json:"skipped,omitempty"`
+	// Error is why listing resources failed.
+	Error     string           `json:"error,omitempty"`
+	Resources []ResourceReport `json:"resources,omitempty"`
+	// Ignored is the number of resources not selected by the filter, or that no longer exist.
+	Ignored int `json:"ignored"`
+}
+
+// ResourceReport describes a selected resource.
+// The resource's description is empty if its sweeper can't describe it.
+type ResourceReport struct {
+	inspect.Resource
+	Action Action `json:"action"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Errors returns the number of sweepers and resources that failed.
+func (r *Report) Errors() int {
+	var n int
+
+	for _, s := range r.Sweepers {
+		if s.Error != "" {
+			n++
+		}
+		n += s.failedDeletions()
+	}
+
+	return n
+}
+
+func (r SweeperReport) failedDeletions() int {
+	var n int
+
+	for _, v := range r.Resources {
+		if v.Action == ActionFailed {
+			n++
+		}
+	}
+
+	return n
+}
+
+// err returns why the sweeper failed, if it did.
+// The sweepers that depend on a failed sweeper aren't run.
+func (r SweeperReport) err() error {
+	if r.Error != "" {
+		return errors.New(r.Error)
+	}
+
+	if n := r.failedDeletions(); n > 0 {
+		return fmt.Errorf("deleting %d resources failed", n)
+	}
+
+	return nil
+}
+
+// Run runs the sweepers selected by the options in the specified Region, each after the sweepers it depends on,
+// and deletes (or, in a dry run, reports) the resources that match the filter.
+// Sweepers that don't depend on each other run concurrently.
+// A failed sweeper or deletion is recorded in the report and only stops the sweepers that depend on that sweeper from running.
+func Run(ctx context.Context, region string, client *conns.AWSClient, sweepers map[string]sweep.Sweeper, opts Options) (*Report, error) {
+	names, err := order(sweepers, opts.Filter.selectsResourceType, opts.Dependencies)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		selected[name] = true
+	}
+
+	var mu sync.Mutex
+	reports := make(map[string]SweeperReport, len(names))
+	results, err := schedule.Run(ctx, dependencies(sweepers), func(name string) bool {
+		return selected[name]
+	}, schedule.Options{Parallelism: opts.Parallelism}, func(ctx context.Context, name string) error {
+		report := runSweeper(log.WithResourceType(ctx, name), client, sweepers[name], opts)
+
+		mu.Lock()
+		defer mu.Unlock()
+		reports[name] = report
+
+		return report.err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Region: region,
+		DryRun: opts.DryRun,
+	}
+
+	for _, result := range results {
+		v, ok := reports[result.Name]
+		if !ok {
+			v = SweeperReport{
+				ResourceType: result.Name,
+				Skipped:      result.Err.Error(),
+			}
+		}
+		report.Sweepers = append(report.Sweepers, v)
+	}
+
+	return report, nil
+}
+
+func runSweeper(ctx context.Context, client *conns.AWSClient, s sweep.Sweeper, opts Options) SweeperReport {
+	report := SweeperReport{
+		ResourceType: s.Name,
+	}
+
+	tflog.Info(ctx, "listing resources")
+	sweepables, err := s.F(ctx, client)
+
+	if awsv2.SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		report.Skipped = err.Error()
+		return report
+	}
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+
+	selected := selectResources(ctx, client, sweepables, opts.Filter)
+	report.Ignored = len(sweepables) - len(selected)
+
+	report.Resources = make([]ResourceReport, len(selected))
+	var wg sync.WaitGroup
+	for i, v := range selected {
+		report.Resources[i].Resource = v.description
+
+		if opts.DryRun {
+			report.Resources[i].Action = ActionWouldDelete
+			continue
+		}
+
+		wg.Go(func() {
+			if err := v.sweepable.Delete(ctx); err != nil {
+				report.Resources[i].Action = ActionFailed
+				report.Resources[i].Error = err.Error()
+				return
+			}
+
+			report.Resources[i].Action = ActionDeleted
+		})
+	}
+	wg.Wait()
+
+	return report
+}
+
+type selectedResource struct {
+	sweepable   sweep.Sweepable
+	description inspect.Resource
+}
+
+// selectResources describes the sweepable resources and returns those that match the filter.
+// Resources that can't be described are only selected if the filter doesn't need a description.
+func selectResources(ctx context.Context, client *conns.AWSClient, sweepables []sweep.Sweepable, filter Filter) []selectedResource {
+	now := clock.FromContext(ctx).Now()
+	selected := make([]*selectedResource, len(sweepables))
+
+	var wg sync.WaitGroup
+	for i, sweepable := range sweepables {
+		v, ok := sweepable.(inspect.Inspectable)
+		if !ok {
+			if !filter.needsInspection() {
+				selected[i] = &selectedResource{sweepable: sweepable}
+			}
+			continue
+		}
+
+		wg.Go(func() {
+			description, err := v.Inspect(ctx)
+
+			if retry.NotFound(err) {
+				return
+			}
+
+			if err != nil {
+				tflog.Warn(ctx, "Describing resource", map[string]any{
+					"error": err.Error(),
+				})
+				return
+			}
+
+			if description.Tags == nil && description.ARN != "" && len(filter.Tags) > 0 && client.BulkTagRefreshEnabled(ctx) {
+				tags, err := client.BulkListTags(ctx, description.ARN)
+				switch {
+				case retry.NotFound(err):
+					// The Resource Groups Tagging API doesn't return resources that have never been tagged.
+					tags = map[string]string{}
+				case err != nil:
+					tflog.Warn(ctx, "Listing resource tags", map[string]any{
+						"error": err.Error(),
+						"arn":   description.ARN,
+					})
+				}
+				description.Tags = tags
+			}
+
+			if filter.Match(description, now) {
+				selected[i] = &selectedResource{
+					sweepable:   sweepable,
+					description: description,
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	var result []selectedResource
+	for _, v := range selected {
+		if v != nil {
+			result = append(result, *v)
+		}
+	}
+
+	return result
+}
+
+// order returns the names of the selected sweepers (and, optionally, the sweepers they depend on),
+// each after all of the sweepers it depends on, directly or indirectly.
+func order(sweepers map[string]sweep.Sweeper, selected func(string) bool, withDependencies bool) ([]string, error) {
+	include := make(map[string]bool)
+	var includeDependencies func(string) error
+	includeDependencies = func(name string) error {
+		for _, dependency := range sweepers[name].Dependencies {
+			if _, ok := sweepers[dependency]; !ok {
+				return fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+			}
+			if !include[dependency] {
+				include[dependency] = true
+				if err := includeDependencies(dependency); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(sweepers)) {
+		if !selected(name) {
+			continue
+		}
+		include[name] = true
+		if withDependencies {
+			if err := includeDependencies(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return schedule.Order(dependencies(sweepers), func(name string) bool {
+		return include[name]
+	})
+}
+
+// dependencies returns the names of the sweepers that each sweeper depends on.
+func dependencies(sweepers map[string]sweep.Sweeper) map[string][]string {
+	result := make(map[string][]string, len(sweepers))
+
+	for name, s := range sweepers {
+		result[name] = s.Dependencies
+	}
+
+	return result
+}
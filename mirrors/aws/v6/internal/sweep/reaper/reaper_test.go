@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package reaper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/clock"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/inspect"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/tfresource"
+)
+
+type testResource struct {
+	description inspect.Resource
+	inspectErr  error
+	deleteErr   error
+
+	mu      sync.Mutex
+	deleted bool
+}
+
+func (r *testResource) Inspect(context.Context) (inspect.Resource, error) {
+	return r.description, r.inspectErr
+}
+
+func (r *testResource) Delete(context.Context, ...tfresource.OptionsFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deleted = true
+	return r.deleteErr
+}
+
+func testSweeper(name string, resources []*testResource, dependencies ...string) sweep.Sweeper {
+	return sweep.Sweeper{
+		Name: name,
+		F: func(context.Context, *conns.AWSClient) ([]sweep.Sweepable, error) {
+			var sweepables []sweep.Sweepable
+			for _, v := range resources {
+				sweepables = append(sweepables, v)
+			}
+			return sweepables, nil
+		},
+		Dependencies: dependencies,
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := clock.NewContext(t.Context(), clock.NewVirtual(now))
+
+	old := &testResource{description: inspect.Resource{ID: "old", CreatedAt: now.Add(-48 * time.Hour)}}
+	recent := &testResource{description: inspect.Resource{ID: "recent", CreatedAt: now.Add(-time.Hour)}}
+	gone := &testResource{inspectErr: &retry.NotFoundError{}}
+	failing := &testResource{description: inspect.Resource{ID: "failing", CreatedAt: now.Add(-48 * time.Hour)}, deleteErr: errors.New("in use")}
+	dependent := &testResource{description: inspect.Resource{ID: "dependent", CreatedAt: now.Add(-48 * time.Hour)}}
+
+	sweepers := map[string]sweep.Sweeper{
+		"aws_a": testSweeper("aws_a", []*testResource{old, recent, gone}, "aws_b"),
+		"aws_b": testSweeper("aws_b", nil),
+		"aws_c": {
+			Name: "aws_c",
+			F: func(context.Context, *conns.AWSClient) ([]sweep.Sweepable, error) {
+				return nil, errors.New("listing failed")
+			},
+		},
+		"aws_d": testSweeper("aws_d", []*testResource{failing}),
+		"aws_e": testSweeper("aws_e", []*testResource{dependent}, "aws_d"),
+	}
+	opts := Options{
+		Filter:      Filter{OlderThan: 24 * time.Hour},
+		Parallelism: 2,
+	}
+
+	report, err := Run(ctx, "us-west-2", new(conns.AWSClient), sweepers, opts) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(report.Sweepers), 5; got != want {
+		t.Fatalf("%d sweepers, want %d", got, want)
+	}
+	// Dependencies run first.
+	if got, want := report.Sweepers[0].ResourceType, "aws_b"; got != want {
+		t.Errorf("first sweeper = %s, want %s", got, want)
+	}
+	if got, want := report.Sweepers[1].Resources, []ResourceReport{{Resource: old.description, Action: ActionDeleted}}; !slices.EqualFunc(got, want, equalResourceReports) {
+		t.Errorf("aws_a resources = %v, want %v", got, want)
+	}
+	if got, want := report.Sweepers[1].Ignored, 2; got != want {
+		t.Errorf("aws_a ignored = %d, want %d", got, want)
+	}
+	if got, want := report.Sweepers[2].Error, "listing failed"; got != want {
+		t.Errorf("aws_c error = %q, want %q", got, want)
+	}
+	// A failed deletion stops the sweepers that depend on it.
+	if got, want := report.Sweepers[4].Skipped, "dependency (aws_d) failed"; got != want {
+		t.Errorf("aws_e skipped = %q, want %q", got, want)
+	}
+	if got, want := report.Errors(), 2; got != want {
+		t.Errorf("Errors() = %d, want %d", got, want)
+	}
+	if !old.deleted || recent.deleted || gone.deleted || !failing.deleted || dependent.deleted {
+		t.Errorf("deleted: old %t, recent %t, gone %t, failing %t, dependent %t", old.deleted, recent.deleted, gone.deleted, failing.deleted, dependent.deleted)
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	resource := &testResource{description: inspect.Resource{ID: "r"}}
+	sweepers := map[string]sweep.Sweeper{
+		"aws_a": testSweeper("aws_a", []*testResource{resource}),
+	}
+
+	report, err := Run(ctx, "us-west-2", new(conns.AWSClient), sweepers, Options{DryRun: true}) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := report.Sweepers[0].Resources, []ResourceReport{{Resource: resource.description, Action: ActionWouldDelete}}; !slices.EqualFunc(got, want, equalResourceReports) {
+		t.Errorf("resources = %v, want %v", got, want)
+	}
+	if resource.deleted {
+		t.Error("resource deleted in dry run")
+	}
+}
+
+func TestOrder(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]sweep.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_c"}},
+		"aws_c": {Name: "aws_c"},
+		"aws_d": {Name: "aws_d"},
+	}
+
+	testCases := []struct {
+		name             string
+		resourceTypes    string
+		withDependencies bool
+		want             []string
+	}{
+		{
+			name: "all",
+			want: []string{"aws_c", "aws_b", "aws_a", "aws_d"},
+		},
+		{
+			name:          "selected",
+			resourceTypes: `^aws_(a|c)$`,
+			want:          []string{"aws_c", "aws_a"},
+		},
+		{
+			name:             "with dependencies",
+			resourceTypes:    `^aws_a$`,
+			withDependencies: true,
+			want:             []string{"aws_c", "aws_b", "aws_a"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var filter Filter
+			if testCase.resourceTypes != "" {
+				filter.ResourceTypes = regexache.MustCompile(testCase.resourceTypes)
+			}
+
+			got, err := order(sweepers, filter.selectsResourceType, testCase.withDependencies)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("order() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestOrderErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]sweep.Sweeper{
+		"missing dependency": {
+			"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		},
+		"cycle": {
+			"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+			"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+		},
+	}
+
+	for name, sweepers := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := order(sweepers, Filter{}.selectsResourceType, false); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func equalResourceReports(a, b ResourceReport) bool {
+	return a.ID == b.ID && a.Action == b.Action && a.Error == b.Error
+}
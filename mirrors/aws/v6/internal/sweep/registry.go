@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"maps"
+	"sync"
+)
+
+// Sweeper lists the resources of a single resource type to be swept.
+type Sweeper struct {
+	// Name is the resource type, e.g. "aws_sqs_queue".
+	Name string
+	F    SweeperFn
+	// Dependencies are the names of the sweepers to run before this one,
+	// e.g. those of resource types that must be deleted first.
+	Dependencies []string
+}
+
+var (
+	sweepersMu sync.Mutex
+	sweepers   = make(map[string]Sweeper)
+)
+
+// RegisterSweeper adds a sweeper to the registry used outside of the test framework.
+// It panics if a sweeper with the same name is already registered.
+func RegisterSweeper(s Sweeper) {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+
+	if _, ok := sweepers[s.Name]; ok {
+		panic(fmt.Sprintf("duplicate sweeper: %s", s.Name))
+	}
+
+	sweepers[s.Name] = s
+}
+
+// Sweepers returns the registered sweepers, keyed by name.
+func Sweepers() map[string]Sweeper {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+
+	return maps.Clone(sweepers)
+}
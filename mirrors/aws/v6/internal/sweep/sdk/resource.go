@@ -6,12 +6,17 @@ package sdk
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/errs/sdkdiag"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/flex"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/inspect"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/tfresource"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
 )
 
 type sweepResource struct {
@@ -39,6 +44,57 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+// Inspect reads the resource and describes it.
+func (sr *sweepResource) Inspect(ctx context.Context) (inspect.Resource, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return inspect.Resource{}, err
+	}
+
+	if sr.d.Id() == "" {
+		return inspect.Resource{}, &retry.NotFoundError{}
+	}
+
+	schema := sr.resource.SchemaMap()
+	getString := func(k string) string {
+		if _, ok := schema[k]; !ok {
+			return ""
+		}
+		v, _ := sr.d.Get(k).(string)
+		return v
+	}
+
+	v := inspect.Resource{
+		ID:   sr.d.Id(),
+		Name: getString(names.AttrName),
+		ARN:  getString(names.AttrARN),
+	}
+	if v.ARN == "" && arn.IsARN(v.ID) {
+		v.ARN = v.ID
+	}
+
+	// Resources with transparent tagging don't read their tags themselves.
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := schema[k]; !ok {
+			continue
+		}
+		if m, ok := sr.d.Get(k).(map[string]any); ok && len(m) > 0 {
+			v.Tags = flex.ExpandStringValueMap(m)
+			break
+		}
+	}
+
+	for _, k := range inspect.CreatedAtAttributes {
+		if t, ok := inspect.ParseTime(getString(k)); ok {
+			v.CreatedAt = t
+			break
+		}
+	}
+
+	return v, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -58,6 +58,7 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	meta.SetServicePackages(ctx, servicePackageMap)
 
 	conf := &conns.Config{
+		BulkTagRefresh:   true, // Used by the reaper to read tags.
 		MaxRetries:       5,
 		Region:           region,
 		SuppressDebugLog: true,
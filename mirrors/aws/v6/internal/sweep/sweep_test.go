@@ -7,8 +7,8 @@ import (
 	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/sweep/awsv2"
 )
 
 func TestMain(m *testing.M) {
@@ -18,5 +18,5 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
-	resource.TestMain(m)
+	awsv2.TestMain(m)
 }
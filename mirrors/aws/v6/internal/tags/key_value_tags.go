@@ -53,17 +53,37 @@ const (
 	// Valid values are "error", "warning", and "disabled". Any other value will trigger an error
 	// during provider initialization.
 	TagPolicyComplianceEnvVar = "TF_AWS_TAG_POLICY_COMPLIANCE"
+
+	// Environment variable specifying a local organizational tag policy document, either its
+	// JSON content or the path to a file containing it
+	//
+	// The value in the provider configuration takes precedence.
+	TagPolicyDocumentEnvVar = "TF_AWS_TAG_POLICY_DOCUMENT"
 )
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// Rules scope default tags to resource types or service packages
+	//
+	// Rules are applied in order by ForResource.
+	Rules []DefaultRule
+
+	// PropagateAtLaunch determines whether default tags applied to Auto Scaling Groups
+	// are propagated to the instances they launch
+	PropagateAtLaunch bool
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+
+	// Rules scope ignored tags to resource types or service packages
+	//
+	// Rules are applied by ForResource.
+	Rules []IgnoreRule
 }
 
 // TagPolicyConfig contains options related to organizational tagging policies.
@@ -78,6 +98,64 @@ type TagPolicyConfig struct {
 	// RequiredTags is a mapping of Terraform resource type names to the required
 	// tags defined in the effective tag policy
 	RequiredTags map[string]KeyValueTags
+
+	// AllowedTagValues is a mapping of Terraform resource type names to tag keys to
+	// the values allowed by the effective tag policy. A "*" in an allowed value
+	// matches any sequence of characters
+	AllowedTagValues map[string]map[string][]string
+
+	// Document is the JSON content of, or the path to, a local tag policy document
+	//
+	// When set, required tags are read from the document instead of being retrieved
+	// from AWS.
+	Document string
+}
+
+// DisallowedTagValues returns the sorted keys of tags whose values are not allowed
+// by the tag policy for the specified Terraform resource type.
+func (c *TagPolicyConfig) DisallowedTagValues(typeName string, tags KeyValueTags) []string {
+	if c == nil {
+		return nil
+	}
+
+	var keys []string
+	for key, allowedValues := range c.AllowedTagValues[typeName] {
+		v, ok := tags[key]
+		if !ok {
+			continue
+		}
+		if !slices.ContainsFunc(allowedValues, func(allowedValue string) bool {
+			return matchTagValue(allowedValue, v.ValueString())
+		}) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// matchTagValue returns whether a tag value matches a tag policy value, in which "*" matches any sequence of characters.
+func matchTagValue(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+
+	first, last := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(value, first) {
+		return false
+	}
+	value = value[len(first):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+
+	return strings.HasSuffix(value, last)
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -523,6 +601,30 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	return result
 }
 
+// CaseInsensitiveDuplicates returns the keys of default tags that collide with keys of the given tags
+// on the specified service, mapped to the colliding key of the given tags.
+// Keys collide if they differ only in case, e.g. "Name" and "name", and the service treats such keys as the same key.
+// Equal keys don't collide, as the given tags override default tags.
+func (dc *DefaultConfig) CaseInsensitiveDuplicates(serviceName string, tags KeyValueTags) map[string]string {
+	if dc == nil || len(dc.Tags) == 0 || !names.TagKeysCaseInsensitive(serviceName) {
+		return nil
+	}
+
+	var result map[string]string
+	for defaultKey := range dc.Tags {
+		for k := range tags {
+			if k != defaultKey && strings.EqualFold(k, defaultKey) {
+				if result == nil {
+					result = make(map[string]string)
+				}
+				result[defaultKey] = k
+			}
+		}
+	}
+
+	return result
+}
+
 // String returns the default string representation of the KeyValueTags.
 func (tags KeyValueTags) String() string {
 	var builder strings.Builder
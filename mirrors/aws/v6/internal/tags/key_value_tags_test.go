@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"maps"
 	"slices"
 	"testing"
 
@@ -2214,6 +2215,76 @@ func TestKeyValueTagsHash(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigCaseInsensitiveDuplicates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Name":       "default",
+			"CostCenter": "1234",
+		}),
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		serviceName   string
+		tags          KeyValueTags
+		want          map[string]string
+	}{
+		{
+			name:        "no config",
+			serviceName: names.IAM,
+			tags: New(ctx, map[string]string{
+				"name": "resource",
+			}),
+		},
+		{
+			name:          "case-sensitive service",
+			defaultConfig: defaultConfig,
+			serviceName:   names.EC2,
+			tags: New(ctx, map[string]string{
+				"name": "resource",
+			}),
+		},
+		{
+			name:          "equal keys",
+			defaultConfig: defaultConfig,
+			serviceName:   names.IAM,
+			tags: New(ctx, map[string]string{
+				"Name": "resource",
+			}),
+		},
+		{
+			name:          "collision",
+			defaultConfig: defaultConfig,
+			serviceName:   names.IAM,
+			tags: New(ctx, map[string]string{
+				"name":       "resource",
+				"costcenter": "5678",
+				"Project":    "test",
+			}),
+			want: map[string]string{
+				"Name":       "name",
+				"CostCenter": "costcenter",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.CaseInsensitiveDuplicates(testCase.serviceName, testCase.tags)
+
+			if !maps.Equal(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 	t.Parallel()
 
@@ -2888,6 +2959,71 @@ func TestKeyValueTagsString(t *testing.T) {
 	}
 }
 
+func TestTagPolicyConfigDisallowedTagValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &TagPolicyConfig{
+		AllowedTagValues: map[string]map[string][]string{
+			"aws_test": {
+				"env":   {"dev", "prod"},
+				"owner": {"team-*"},
+				"cost":  {"cc-*-*9"},
+			},
+		},
+	}
+	testCases := []struct {
+		name     string
+		policy   *TagPolicyConfig
+		typeName string
+		tags     KeyValueTags
+		want     []string
+	}{
+		{
+			name:     "nil policy",
+			typeName: "aws_test",
+			tags:     New(ctx, map[string]string{"env": "test"}),
+		},
+		{
+			name:     "other type",
+			policy:   policy,
+			typeName: "aws_other",
+			tags:     New(ctx, map[string]string{"env": "test"}),
+		},
+		{
+			name:     "allowed",
+			policy:   policy,
+			typeName: "aws_test",
+			tags:     New(ctx, map[string]string{"env": "prod", "owner": "team-a", "cost": "cc-1-19", "other": "x"}),
+		},
+		{
+			name:     "absent",
+			policy:   policy,
+			typeName: "aws_test",
+			tags:     New(ctx, map[string]string{}),
+		},
+		{
+			name:     "disallowed",
+			policy:   policy,
+			typeName: "aws_test",
+			tags:     New(ctx, map[string]string{"env": "Prod", "owner": "teams", "cost": "cc-1-8"}),
+			want:     []string{"cost", "env", "owner"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policy.DisallowedTagValues(testCase.typeName, testCase.tags)
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func testKeyValueTagsVerifyKeys(t *testing.T, got []string, want []string) {
 	for _, g := range got {
 		found := slices.Contains(want, g)
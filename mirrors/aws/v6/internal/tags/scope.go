@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"slices"
+)
+
+// Scope selects the resources to which a tag rule applies.
+// A Scope with no resource types or service packages selects all resources.
+type Scope struct {
+	// ResourceTypes are Terraform resource type names, in which "*" matches any sequence of characters, e.g. "aws_iam_*"
+	ResourceTypes []string
+
+	// ServicePackages are service package names, e.g. "ec2"
+	ServicePackages []string
+}
+
+// Matches returns whether the scope selects resources of the specified type in the specified service package.
+func (s Scope) Matches(typeName, servicePackageName string) bool {
+	if len(s.ResourceTypes) == 0 && len(s.ServicePackages) == 0 {
+		return true
+	}
+
+	if slices.Contains(s.ServicePackages, servicePackageName) {
+		return true
+	}
+
+	return slices.ContainsFunc(s.ResourceTypes, func(pattern string) bool {
+		return matchTagValue(pattern, typeName)
+	})
+}
+
+// DefaultRule adds or removes default tags for the resources selected by its scope.
+type DefaultRule struct {
+	Scope Scope
+
+	// Tags are default tags added to, or overriding, the provider-wide default tags
+	Tags KeyValueTags
+
+	// ExcludeKeys are keys of default tags that are not applied
+	ExcludeKeys KeyValueTags
+}
+
+// IgnoreRule ignores additional tags for the resources selected by its scope.
+type IgnoreRule struct {
+	Scope Scope
+
+	Keys        KeyValueTags
+	KeyPrefixes KeyValueTags
+}
+
+// ForResource returns the default tags configuration in effect for resources of the specified type
+// in the specified service package, i.e. with any matching rules applied.
+// The result has no rules, so MergeTags, RemoveDefaultConfig and TagsEqual honor the scoping.
+func (dc *DefaultConfig) ForResource(typeName, servicePackageName string) *DefaultConfig {
+	if dc == nil || len(dc.Rules) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+	for _, rule := range dc.Rules {
+		if !rule.Scope.Matches(typeName, servicePackageName) {
+			continue
+		}
+
+		tags = tags.Merge(rule.Tags).Ignore(rule.ExcludeKeys)
+	}
+
+	return &DefaultConfig{
+		Tags:              tags,
+		PropagateAtLaunch: dc.PropagateAtLaunch,
+	}
+}
+
+// ForResource returns the ignore tags configuration in effect for resources of the specified type
+// in the specified service package, i.e. with any matching rules applied.
+func (ic *IgnoreConfig) ForResource(typeName, servicePackageName string) *IgnoreConfig {
+	if ic == nil || len(ic.Rules) == 0 {
+		return ic
+	}
+
+	keys, keyPrefixes := ic.Keys, ic.KeyPrefixes
+	for _, rule := range ic.Rules {
+		if !rule.Scope.Matches(typeName, servicePackageName) {
+			continue
+		}
+
+		if len(rule.Keys) > 0 {
+			keys = keys.Merge(rule.Keys)
+		}
+		if len(rule.KeyPrefixes) > 0 {
+			keyPrefixes = keyPrefixes.Merge(rule.KeyPrefixes)
+		}
+	}
+
+	return &IgnoreConfig{
+		Keys:        keys,
+		KeyPrefixes: keyPrefixes,
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"maps"
+	"testing"
+)
+
+func TestScopeMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		scope              Scope
+		typeName           string
+		servicePackageName string
+		want               bool
+	}{
+		{
+			name:               "empty",
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+			want:               true,
+		},
+		{
+			name:               "service package",
+			scope:              Scope{ServicePackages: []string{"ec2", "elbv2"}},
+			typeName:           "aws_lb",
+			servicePackageName: "elbv2",
+			want:               true,
+		},
+		{
+			name:               "service package not matching",
+			scope:              Scope{ServicePackages: []string{"ec2", "elbv2"}},
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+			want:               false,
+		},
+		{
+			name:               "resource type pattern",
+			scope:              Scope{ResourceTypes: []string{"aws_iam_*"}},
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+			want:               true,
+		},
+		{
+			name:               "resource type pattern not matching",
+			scope:              Scope{ResourceTypes: []string{"aws_iam_*"}},
+			typeName:           "aws_s3_bucket",
+			servicePackageName: "s3",
+			want:               false,
+		},
+		{
+			name:               "resource type exact",
+			scope:              Scope{ResourceTypes: []string{"aws_instance"}},
+			typeName:           "aws_instance",
+			servicePackageName: "ec2",
+			want:               true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.scope.Matches(testCase.typeName, testCase.servicePackageName), testCase.want; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"Backup": "daily",
+			"Owner":  "platform",
+		}),
+		Rules: []DefaultRule{
+			{
+				Scope:       Scope{ResourceTypes: []string{"aws_iam_*"}},
+				ExcludeKeys: New(ctx, []string{"Backup"}),
+			},
+			{
+				Scope: Scope{ServicePackages: []string{"s3"}},
+				Tags: New(ctx, map[string]string{
+					"Owner":     "storage",
+					"DataClass": "internal",
+				}),
+			},
+		},
+		PropagateAtLaunch: true,
+	}
+
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		typeName           string
+		servicePackageName string
+		want               map[string]string
+	}{
+		{
+			name:               "no config",
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+		},
+		{
+			name:               "excluded",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+			want: map[string]string{
+				"Owner": "platform",
+			},
+		},
+		{
+			name:               "added and overridden",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_s3_bucket",
+			servicePackageName: "s3",
+			want: map[string]string{
+				"Backup":    "daily",
+				"DataClass": "internal",
+				"Owner":     "storage",
+			},
+		},
+		{
+			name:               "not matching",
+			defaultConfig:      defaultConfig,
+			typeName:           "aws_instance",
+			servicePackageName: "ec2",
+			want: map[string]string{
+				"Backup": "daily",
+				"Owner":  "platform",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.typeName, testCase.servicePackageName)
+
+			if testCase.defaultConfig == nil {
+				if got != nil {
+					t.Fatalf("got %v, want nil", got)
+				}
+				return
+			}
+
+			if len(got.Rules) != 0 {
+				t.Errorf("got %d rules, want none", len(got.Rules))
+			}
+			if !got.PropagateAtLaunch {
+				t.Error("PropagateAtLaunch not preserved")
+			}
+			if got, want := got.GetTags().Map(), testCase.want; !maps.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+			if got, want := got.MergeTags(New(ctx, map[string]string{"Name": "test"})).Map(), testCase.want; len(got) != len(want)+1 {
+				t.Errorf("merged: got %v, want %v and Name", got, want)
+			}
+		})
+	}
+}
+
+func TestIgnoreConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ignoreConfig := &IgnoreConfig{
+		Keys: New(ctx, []string{"LastScanned"}),
+		Rules: []IgnoreRule{
+			{
+				Scope:       Scope{ServicePackages: []string{"ec2", "elbv2"}},
+				KeyPrefixes: New(ctx, []string{"kubernetes.io/"}),
+			},
+		},
+	}
+	tags := New(ctx, map[string]string{
+		"LastScanned":                 "yesterday",
+		"Name":                        "test",
+		"kubernetes.io/cluster/test1": "owned",
+	})
+
+	testCases := []struct {
+		name               string
+		typeName           string
+		servicePackageName string
+		want               map[string]string
+	}{
+		{
+			name:               "matching",
+			typeName:           "aws_lb",
+			servicePackageName: "elbv2",
+			want: map[string]string{
+				"Name": "test",
+			},
+		},
+		{
+			name:               "not matching",
+			typeName:           "aws_iam_role",
+			servicePackageName: "iam",
+			want: map[string]string{
+				"Name":                        "test",
+				"kubernetes.io/cluster/test1": "owned",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tags.IgnoreConfig(ignoreConfig.ForResource(testCase.typeName, testCase.servicePackageName)).Map()
+
+			if want := testCase.want; !maps.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	tftags "github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+)
+
+const (
+	// allSupported is the tag policy resource type suffix matching all of a service's resource types, e.g. "ec2:ALL_SUPPORTED".
+	allSupported = "ALL_SUPPORTED"
+	// assignOperator is the tag policy inheritance operator assigning a value.
+	assignOperator = "@@assign"
+)
+
+// ReadDocument returns the content of an AWS Organizations tag policy document.
+// v is either the document's JSON content or the path to a file containing it.
+func ReadDocument(v string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(v), "{") {
+		return []byte(v), nil
+	}
+
+	b, err := os.ReadFile(v)
+	if err != nil {
+		return nil, fmt.Errorf("reading tag policy document: %w", err)
+	}
+
+	return b, nil
+}
+
+// GetRequiredTagsFromDocument returns the required tags per Terraform resource type defined by an
+// AWS Organizations tag policy document, e.g. an account's effective tag policy.
+// A tag is required for the resource types listed in its `report_required_tag_for` or `enforced_for` rules.
+// The returned allowed values are those of the tag's `tag_value` rule, keyed by Terraform resource type and tag key.
+func GetRequiredTagsFromDocument(ctx context.Context, document []byte) (map[string]tftags.KeyValueTags, map[string]map[string][]string, error) {
+	var policy struct {
+		Tags map[string]map[string]json.RawMessage `json:"tags"`
+	}
+	if err := json.Unmarshal(document, &policy); err != nil {
+		return nil, nil, fmt.Errorf("parsing tag policy document: %w", err)
+	}
+
+	reqTags := make(map[string]tftags.KeyValueTags)
+	allowedValues := make(map[string]map[string][]string)
+	for name, rules := range policy.Tags {
+		if strings.HasPrefix(name, "@@") {
+			continue
+		}
+
+		key := name
+		if v, ok := rules["tag_key"]; ok {
+			if err := unmarshalRule(v, &key); err != nil {
+				return nil, nil, fmt.Errorf("parsing tag policy document: tag %s: tag_key: %w", name, err)
+			}
+		}
+
+		var values []string
+		if v, ok := rules["tag_value"]; ok {
+			if err := unmarshalRule(v, &values); err != nil {
+				return nil, nil, fmt.Errorf("parsing tag policy document: tag %s: tag_value: %w", name, err)
+			}
+		}
+
+		var resourceTypes []string
+		for _, rule := range []string{"report_required_tag_for", "enforced_for"} {
+			if v, ok := rules[rule]; ok {
+				var ruleResourceTypes []string
+				if err := unmarshalRule(v, &ruleResourceTypes); err != nil {
+					return nil, nil, fmt.Errorf("parsing tag policy document: tag %s: %s: %w", name, rule, err)
+				}
+				resourceTypes = append(resourceTypes, ruleResourceTypes...)
+			}
+		}
+
+		newTags := tftags.New(ctx, []string{key})
+		for _, tfType := range terraformTypes(resourceTypes) {
+			if v, ok := reqTags[tfType]; ok {
+				reqTags[tfType] = v.Merge(newTags)
+			} else {
+				reqTags[tfType] = newTags
+			}
+
+			if len(values) > 0 {
+				if _, ok := allowedValues[tfType]; !ok {
+					allowedValues[tfType] = make(map[string][]string)
+				}
+				allowedValues[tfType][key] = values
+			}
+		}
+	}
+
+	return reqTags, allowedValues, nil
+}
+
+// unmarshalRule unmarshals a tag policy rule, either a plain value or an object with an "@@assign" operator.
+func unmarshalRule(data json.RawMessage, v any) error {
+	var operators map[string]json.RawMessage
+	if err := json.Unmarshal(data, &operators); err == nil {
+		assign, ok := operators[assignOperator]
+		if !ok {
+			// Other inheritance operators only apply when merging policies.
+			return nil
+		}
+		data = assign
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// terraformTypes returns the Terraform resource types corresponding to tag policy resource types.
+func terraformTypes(resourceTypes []string) []string {
+	var tfTypes []string
+	for _, resourceType := range resourceTypes {
+		if service, ok := strings.CutSuffix(resourceType, ":"+allSupported); ok {
+			for k, v := range Lookup {
+				if strings.HasPrefix(k, service+":") {
+					tfTypes = append(tfTypes, v...)
+				}
+			}
+			continue
+		}
+
+		tfTypes = append(tfTypes, Lookup[resourceType]...)
+	}
+	return tfTypes
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tagpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestGetRequiredTagsFromDocument(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	document := []byte(`{
+  "tags": {
+    "owner": {
+      "tag_key": {"@@assign": "Owner"},
+      "tag_value": {"@@assign": ["team-*"]},
+      "report_required_tag_for": {"@@assign": ["logs:log-group"]}
+    },
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "enforced_for": ["acm:certificate", "logs:log-group"]
+    },
+    "project": {
+      "tag_key": {"@@assign": "Project"},
+      "tag_value": {"@@append": ["x"]}
+    }
+  }
+}`)
+
+	reqTags, allowedValues, err := GetRequiredTagsFromDocument(ctx, document)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, want := range map[string][]string{
+		"aws_acm_certificate":      {"CostCenter"},
+		"aws_cloudwatch_log_group": {"CostCenter", "Owner"},
+	} {
+		got := reqTags[typeName].Keys()
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("required tags (%s): got %v, want %v", typeName, got, want)
+		}
+	}
+	if got, want := len(reqTags), 2; got != want {
+		t.Errorf("required tags: got %d types, want %d", got, want)
+	}
+
+	if got, want := allowedValues["aws_cloudwatch_log_group"]["Owner"], []string{"team-*"}; !slices.Equal(got, want) {
+		t.Errorf("allowed values: got %v, want %v", got, want)
+	}
+	if got, want := len(allowedValues), 1; got != want {
+		t.Errorf("allowed values: got %d types, want %d", got, want)
+	}
+}
+
+func TestGetRequiredTagsFromDocumentAllSupported(t *testing.T) {
+	t.Parallel()
+
+	reqTags, _, err := GetRequiredTagsFromDocument(t.Context(), []byte(`{"tags": {"owner": {"enforced_for": {"@@assign": ["xray:ALL_SUPPORTED"]}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, typeName := range []string{"aws_xray_group", "aws_xray_sampling_rule"} {
+		if _, ok := reqTags[typeName]["owner"]; !ok {
+			t.Errorf("expected owner tag to be required for %s", typeName)
+		}
+	}
+}
+
+func TestGetRequiredTagsFromDocumentInvalid(t *testing.T) {
+	t.Parallel()
+
+	for name, document := range map[string]string{
+		"not JSON":      `tags`,
+		"invalid rule":  `{"tags": {"owner": {"enforced_for": {"@@assign": "logs:log-group"}}}}`,
+		"invalid value": `{"tags": {"owner": {"tag_value": 42}}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, _, err := GetRequiredTagsFromDocument(t.Context(), []byte(document)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestReadDocument(t *testing.T) {
+	t.Parallel()
+
+	const document = `{"tags": {}}`
+
+	got, err := ReadDocument(" " + document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != " "+document {
+		t.Errorf("inline: got %s", got)
+	}
+
+	filename := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(filename, []byte(document), 0600); err != nil {
+		t.Fatal(err)
+	}
+	got, err = ReadDocument(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != document {
+		t.Errorf("file: got %s", got)
+	}
+
+	if _, err := ReadDocument(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
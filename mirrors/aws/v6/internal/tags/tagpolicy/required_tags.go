@@ -5,6 +5,7 @@ package tagpolicy
 
 import (
 	"context"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
@@ -50,3 +51,21 @@ func convert(ctx context.Context, reqTags []types.RequiredTag) map[string]tftags
 	}
 	return m
 }
+
+// terraformTypeLookup cross references Terraform resource types to Tagris resource type names.
+var terraformTypeLookup = sync.OnceValue(func() map[string]string {
+	m := make(map[string]string)
+	for resourceType, tfTypes := range Lookup {
+		for _, tfType := range tfTypes {
+			m[tfType] = resourceType
+		}
+	}
+	return m
+})
+
+// IsSupported returns whether the specified Terraform resource type corresponds to a Tagris resource type,
+// i.e. is supported by tag policies and the Resource Groups Tagging API.
+func IsSupported(typeName string) bool {
+	_, ok := terraformTypeLookup()[typeName]
+	return ok
+}
@@ -0,0 +1,17 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+// TagsAllMode determines how a resource's `tags_all` attribute is managed.
+type TagsAllMode int
+
+const (
+	// TagsAllUpstreamComputed is the default mode.
+	// `tags_all` is Computed and its planned value is calculated by the provider from `tags` and any default tags.
+	TagsAllUpstreamComputed TagsAllMode = iota
+
+	// TagsAllCallerManaged indicates that the caller sets `tags_all` before the provider sees it.
+	// The `tags_all` schema is rewritten to match the `tags` schema and the provider does not plan its value.
+	TagsAllCallerManaged
+)
@@ -82,6 +82,8 @@ type ServicePackageFrameworkResource struct {
 	Region   unique.Handle[ServicePackageResourceRegion]
 	Identity Identity
 	Import   FrameworkImport
+	// ExistenceGuard, if set, prevents Create from silently adopting an existing resource.
+	ExistenceGuard FrameworkExistenceGuard
 }
 
 type ServicePackageFrameworkListResource struct {
@@ -113,6 +115,8 @@ type ServicePackageSDKResource struct {
 	Region   unique.Handle[ServicePackageResourceRegion]
 	Identity Identity
 	Import   SDKv2Import
+	// ExistenceGuard, if set, prevents Create from silently adopting an existing resource.
+	ExistenceGuard SDKv2ExistenceGuard
 }
 
 type ListResourceForSDK interface {
@@ -479,6 +483,7 @@ type FrameworkImportIDCreator interface {
 
 type FrameworkImport struct {
 	WrappedImport bool
+	ImportState   bool           // The resource implements resource.ResourceWithImportState
 	ImportID      ImportIDParser // Multi-Parameter
 	SetIDAttr     bool
 }
@@ -493,3 +498,25 @@ type SDKv2Import struct {
 	CustomImport  bool
 	ImportID      SDKv2ImportID // Multi-Parameter
 }
+
+// SDKv2ExistenceGuard is implemented for Plugin SDK resources whose Create operation
+// succeeds and adopts an existing resource with the same identifier, e.g. SNS topics.
+type SDKv2ExistenceGuard interface {
+	// ExpectedID returns the identifier of the resource that Create would create,
+	// or an empty string if it can't be determined before Create, e.g. when the name is generated.
+	ExpectedID(ctx context.Context, d *schema.ResourceData, meta any) (string, error)
+	// Find returns nil if a resource with the specified identifier exists,
+	// or an error satisfying retry.NotFound if it doesn't.
+	Find(ctx context.Context, id string, meta any) error
+}
+
+// FrameworkExistenceGuard is implemented for Plugin Framework resources whose Create operation
+// succeeds and adopts an existing resource with the same identifier.
+type FrameworkExistenceGuard interface {
+	// ExpectedID returns the identifier of the resource that Create would create,
+	// or an empty string if it can't be determined before Create, e.g. when the name is generated.
+	ExpectedID(ctx context.Context, plan tfsdk.Plan, meta any) (string, error)
+	// Find returns nil if a resource with the specified identifier exists,
+	// or an error satisfying retry.NotFound if it doesn't.
+	Find(ctx context.Context, id string, meta any) error
+}
@@ -14,8 +14,10 @@ const (
 	envVarVCRMode = "VCR_MODE"
 	envVarVCRPath = "VCR_PATH"
 
-	vcrModeRecordOnly = "RECORD_ONLY"
-	vcrModeReplayOnly = "REPLAY_ONLY"
+	vcrModeRecordOnly     = "RECORD_ONLY"
+	vcrModeReplayOnly     = "REPLAY_ONLY"
+	vcrModeReplayOrRecord = "REPLAY_OR_RECORD"
+	vcrModeReplayStrict   = "REPLAY_STRICT"
 )
 
 // IsEnabled indicates whether VCR testing is enabled
@@ -27,17 +29,27 @@ func IsEnabled() bool {
 }
 
 // Mode returns the VCR recording mode inferred from the VCR_MODE environment variable
+//
+// REPLAY_OR_RECORD replays recorded interactions and records any others against live AWS.
+// REPLAY_STRICT is REPLAY_ONLY, failing if any recorded interaction isn't replayed (see StrictReplay).
 func Mode() (recorder.Mode, error) {
 	switch v := os.Getenv(envVarVCRMode); v {
 	case vcrModeRecordOnly:
 		return recorder.ModeRecordOnly, nil
-	case vcrModeReplayOnly:
+	case vcrModeReplayOnly, vcrModeReplayStrict:
 		return recorder.ModeReplayOnly, nil
+	case vcrModeReplayOrRecord:
+		return recorder.ModeReplayWithNewEpisodes, nil
 	default:
 		return recorder.ModePassthrough, fmt.Errorf("unsupported value for %s: %s", envVarVCRMode, v)
 	}
 }
 
+// StrictReplay indicates whether the VCR_MODE environment variable requires all recorded interactions to be replayed
+func StrictReplay() bool {
+	return os.Getenv(envVarVCRMode) == vcrModeReplayStrict
+}
+
 // Path returns the directory in which VCR recordings should be stored
 func Path() string {
 	return os.Getenv(envVarVCRPath)
@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"maps"
+	"mime"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/smithy-go/encoding/cbor"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// idempotencyTokenKeys are the names of request parameters whose values are generated anew for each request.
+var idempotencyTokenKeys = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// timestampPlaceholder replaces timestamp values, which vary between recording and replaying, in canonicalized requests.
+const timestampPlaceholder = "<timestamp>"
+
+// cborEpochTimeTag is the CBOR tag number of epoch-based date/time values, used by Smithy to encode timestamps.
+const cborEpochTimeTag = 1
+
+// urlsMatch reports whether a request URL matches a recorded URL.
+// Query parameters may be in a different order.
+func urlsMatch(request, recorded string) bool {
+	if request == recorded {
+		return true
+	}
+
+	u, err := url.Parse(request)
+	if err != nil {
+		return false
+	}
+
+	v, err := url.Parse(recorded)
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != v.Scheme || u.Host != v.Host || u.Path != v.Path {
+		return false
+	}
+
+	return reflect.DeepEqual(canonicalQuery(u.Query()), canonicalQuery(v.Query()))
+}
+
+// bodiesMatch reports whether a request body matches a recorded body, taking into account the AWS protocol
+// used to encode the body, as identified by the request's Content-Type header.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func bodiesMatch(ctx context.Context, contentType, body, recorded string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	var canonicalize func(string) (any, error)
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// awsJson1_0, awsJson1_1 and restJson1.
+		canonicalize = canonicalJSON
+	case "application/xml", "text/xml":
+		// restXml.
+		canonicalize = canonicalXML
+	case "application/x-www-form-urlencoded":
+		// awsQuery and ec2Query.
+		canonicalize = func(s string) (any, error) {
+			values, err := url.ParseQuery(s)
+			if err != nil {
+				return nil, err
+			}
+			return canonicalQuery(values), nil
+		}
+	case "application/cbor":
+		// rpcv2Cbor.
+		canonicalize = canonicalCBOR
+	default:
+		return false
+	}
+
+	requestValue, err := canonicalize(body)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to decode request body", map[string]any{
+			"content_type": contentType,
+			"error":        err,
+		})
+		return false
+	}
+
+	cassetteValue, err := canonicalize(recorded)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to decode cassette body", map[string]any{
+			"content_type": contentType,
+			"error":        err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(requestValue, cassetteValue)
+}
+
+func canonicalJSON(s string) (any, error) {
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return nil, err
+	}
+
+	return canonicalValue(v), nil
+}
+
+// canonicalXML returns the canonical form of an XML document.
+// Each element becomes a map of its name, attributes, text and child elements,
+// with child elements in a canonical order as XML might be the same, but reordered.
+func canonicalXML(s string) (any, error) {
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	root := map[string]any{"children": []any{}}
+	stack := []map[string]any{root}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch token := token.(type) {
+		case xml.StartElement:
+			attrs := make(map[string]any, len(token.Attr))
+			for _, attr := range token.Attr {
+				attrs[attr.Name.Local] = canonicalValue(attr.Value)
+			}
+			element := map[string]any{
+				"name":     token.Name.Local,
+				"attrs":    attrs,
+				"children": []any{},
+				"text":     "",
+			}
+			stack = append(stack, element)
+		case xml.EndElement:
+			element := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if slices.Contains(idempotencyTokenKeys, element["name"].(string)) {
+				continue
+			}
+			element["text"] = canonicalValue(strings.TrimSpace(element["text"].(string)))
+			element["children"] = sortedList(element["children"].([]any))
+			parent = stack[len(stack)-1]
+			parent["children"] = append(parent["children"].([]any), element)
+		case xml.CharData:
+			if text, ok := parent["text"].(string); ok {
+				parent["text"] = text + string(token)
+			}
+		}
+	}
+
+	return root["children"], nil
+}
+
+// canonicalQuery returns the canonical form of AWS Query parameters.
+// Parameter names are split on "." into nested maps, and maps whose keys are all list indices
+// (e.g. the "member" in "Tags.member.1.Key") become lists in a canonical order, so that neither parameter
+// order nor list numbering affects the result.
+func canonicalQuery(values url.Values) any {
+	root := make(map[string]any)
+
+	for name, v := range values {
+		segments := strings.Split(name, ".")
+		if slices.Contains(idempotencyTokenKeys, segments[len(segments)-1]) {
+			continue
+		}
+
+		node := root
+		for _, segment := range segments[:len(segments)-1] {
+			child, ok := node[segment].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				node[segment] = child
+			}
+			node = child
+		}
+
+		node[segments[len(segments)-1]] = canonicalValue(strings.Join(v, ","))
+	}
+
+	return canonicalLists(root)
+}
+
+// canonicalLists replaces maps keyed by list indices with lists sorted in a canonical order.
+func canonicalLists(v any) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	indexed := len(m) > 0
+	for k, v := range m {
+		m[k] = canonicalLists(v)
+		if _, err := strconv.ParseUint(k, 10, 64); err != nil {
+			indexed = false
+		}
+	}
+
+	if !indexed {
+		return m
+	}
+
+	return sortedList(slices.Collect(maps.Values(m)))
+}
+
+func canonicalCBOR(s string) (any, error) {
+	v, err := cbor.Decode([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+
+	return canonicalValue(fromCBOR(v)), nil
+}
+
+// fromCBOR converts a decoded CBOR value to the equivalent value decoded from JSON.
+func fromCBOR(v cbor.Value) any {
+	switch v := v.(type) {
+	case cbor.Uint:
+		return float64(v)
+	case cbor.NegInt:
+		return -1 - float64(v)
+	case cbor.Slice:
+		return base64.StdEncoding.EncodeToString(v)
+	case cbor.String:
+		return string(v)
+	case cbor.List:
+		result := make([]any, len(v))
+		for i, v := range v {
+			result[i] = fromCBOR(v)
+		}
+		return result
+	case cbor.Map:
+		result := make(map[string]any, len(v))
+		for k, v := range v {
+			result[k] = fromCBOR(v)
+		}
+		return result
+	case *cbor.Tag:
+		if v.ID == cborEpochTimeTag {
+			return timestampPlaceholder
+		}
+		return fromCBOR(v.Value)
+	case cbor.Bool:
+		return bool(v)
+	case cbor.Float32:
+		return float64(v)
+	case cbor.Float64:
+		return float64(v)
+	default:
+		return nil
+	}
+}
+
+// canonicalValue removes idempotency tokens from, and replaces timestamps in, a decoded request body.
+func canonicalValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, v := range v {
+			if slices.Contains(idempotencyTokenKeys, k) {
+				continue
+			}
+			result[k] = canonicalValue(v)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, v := range v {
+			result[i] = canonicalValue(v)
+		}
+		return result
+	case string:
+		if _, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return timestampPlaceholder
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// sortedList returns the specified values sorted by their JSON encoding.
+func sortedList(values []any) []any {
+	type element struct {
+		key   string
+		value any
+	}
+
+	elements := make([]element, 0, len(values))
+	for _, v := range values {
+		b, _ := json.Marshal(v)
+		elements = append(elements, element{key: string(b), value: v})
+	}
+	slices.SortFunc(elements, func(a, b element) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	result := make([]any, len(elements))
+	for i, e := range elements {
+		result[i] = e.value
+	}
+
+	return result
+}
@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go/encoding/cbor"
+)
+
+func TestBodiesMatch(t *testing.T) {
+	t.Parallel()
+
+	cborBody := func(m cbor.Map) string {
+		return string(cbor.Encode(m))
+	}
+
+	testCases := []struct {
+		name        string
+		contentType string
+		body        string
+		recorded    string
+		want        bool
+	}{
+		{
+			name:        "json reordered",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"b":2,"a":1}`,
+			recorded:    `{"a":1,"b":2}`,
+			want:        true,
+		},
+		{
+			name:        "json different",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"a":1}`,
+			recorded:    `{"a":2}`,
+		},
+		{
+			name:        "json client token and timestamp",
+			contentType: "application/x-amz-json-1.0",
+			body:        `{"ClientToken":"abc","Name":"test","StartTime":"2024-01-01T00:00:00Z"}`,
+			recorded:    `{"ClientToken":"def","Name":"test","StartTime":"2024-06-30T12:34:56.789Z"}`,
+			want:        true,
+		},
+		{
+			name:        "xml with charset",
+			contentType: "application/xml; charset=utf-8",
+			body:        `<A><B>1</B></A>`,
+			recorded:    `<A>  <B>1</B></A>`,
+			want:        true,
+		},
+		{
+			name:        "xml reordered",
+			contentType: "text/xml",
+			body:        `<A><B>1</B><C x="y">2</C></A>`,
+			recorded:    `<A><C x="y">2</C><B>1</B></A>`,
+			want:        true,
+		},
+		{
+			name:        "xml different",
+			contentType: "application/xml",
+			body:        `<A><B>1</B></A>`,
+			recorded:    `<A><B>2</B></A>`,
+		},
+		{
+			name:        "query reordered",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=CreateTags&Version=2016-11-15&ResourceId.1=i-1234",
+			recorded:    "ResourceId.1=i-1234&Version=2016-11-15&Action=CreateTags",
+			want:        true,
+		},
+		{
+			name:        "query renumbered",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "Action=CreateTags&Tag.1.Key=a&Tag.1.Value=1&Tag.2.Key=b&Tag.2.Value=2",
+			recorded:    "Action=CreateTags&Tag.2.Key=a&Tag.2.Value=1&Tag.1.Key=b&Tag.1.Value=2",
+			want:        true,
+		},
+		{
+			name:        "query member lists",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "Action=TagRole&Tags.member.1.Key=a&Tags.member.1.Value=1&Tags.member.2.Key=b&Tags.member.2.Value=2",
+			recorded:    "Action=TagRole&Tags.member.1.Key=b&Tags.member.1.Value=2&Tags.member.2.Key=a&Tags.member.2.Value=1",
+			want:        true,
+		},
+		{
+			name:        "query different",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "Action=CreateTags&Tag.1.Key=a&Tag.1.Value=1",
+			recorded:    "Action=CreateTags&Tag.1.Key=a&Tag.1.Value=2",
+		},
+		{
+			name:        "query client token",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "Action=RunInstances&ClientToken=abc&MaxCount=1",
+			recorded:    "Action=RunInstances&ClientToken=def&MaxCount=1",
+			want:        true,
+		},
+		{
+			name:        "cbor timestamp",
+			contentType: "application/cbor",
+			body:        cborBody(cbor.Map{"Name": cbor.String("test"), "Time": &cbor.Tag{ID: 1, Value: cbor.Float64(1)}}),
+			recorded:    cborBody(cbor.Map{"Name": cbor.String("test"), "Time": &cbor.Tag{ID: 1, Value: cbor.Float64(2)}}),
+			want:        true,
+		},
+		{
+			name:        "cbor client token",
+			contentType: "application/cbor",
+			body:        cborBody(cbor.Map{"ClientToken": cbor.String("abc"), "Count": cbor.Uint(1)}),
+			recorded:    cborBody(cbor.Map{"ClientToken": cbor.String("def"), "Count": cbor.Uint(1)}),
+			want:        true,
+		},
+		{
+			name:        "cbor different",
+			contentType: "application/cbor",
+			body:        cborBody(cbor.Map{"Count": cbor.Uint(1)}),
+			recorded:    cborBody(cbor.Map{"Count": cbor.NegInt(1)}),
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "application/octet-stream",
+			body:        "a",
+			recorded:    "b",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := bodiesMatch(t.Context(), testCase.contentType, testCase.body, testCase.recorded), testCase.want; got != want {
+				t.Errorf("bodiesMatch() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestURLsMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		url      string
+		recorded string
+		want     bool
+	}{
+		{
+			name:     "identical",
+			url:      "https://s3.amazonaws.com/bucket?tagging=",
+			recorded: "https://s3.amazonaws.com/bucket?tagging=",
+			want:     true,
+		},
+		{
+			name:     "query reordered",
+			url:      "https://s3.amazonaws.com/bucket?list-type=2&prefix=a",
+			recorded: "https://s3.amazonaws.com/bucket?prefix=a&list-type=2",
+			want:     true,
+		},
+		{
+			name:     "different path",
+			url:      "https://s3.amazonaws.com/bucket1",
+			recorded: "https://s3.amazonaws.com/bucket2",
+		},
+		{
+			name:     "different query",
+			url:      "https://s3.amazonaws.com/bucket?prefix=a",
+			recorded: "https://s3.amazonaws.com/bucket?prefix=b",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := urlsMatch(testCase.url, testCase.recorded), testCase.want; got != want {
+				t.Errorf("urlsMatch() = %t, want %t", got, want)
+			}
+		})
+	}
+}
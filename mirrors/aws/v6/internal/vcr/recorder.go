@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+
+	cleanhttp "github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// RecorderOption configures NewRecorder.
+type RecorderOption func(*recorderOptions)
+
+type recorderOptions struct {
+	scrubConfig  ScrubConfig
+	strictReplay bool
+}
+
+// WithScrubConfig configures the replacement of sensitive values in recorded interactions.
+func WithScrubConfig(config ScrubConfig) RecorderOption {
+	return func(o *recorderOptions) {
+		o.scrubConfig = config
+	}
+}
+
+// WithStrictReplay makes stopping a replaying recorder fail if any recorded interaction wasn't replayed,
+// e.g. because the cassette is stale.
+func WithStrictReplay() RecorderOption {
+	return func(o *recorderOptions) {
+		o.strictReplay = true
+	}
+}
+
+// NewRecorder returns a VCR recorder that records AWS API interactions to, or replays them from, the named cassette.
+// Interactions are recorded using the specified transport or, if nil, a default transport.
+// Sensitive values are scrubbed from interactions before they are saved, see ScrubConfig.
+// The recorder must be stopped to save recorded interactions.
+func NewRecorder(ctx context.Context, cassetteName string, mode recorder.Mode, transport http.RoundTripper, optFns ...RecorderOption) (*recorder.Recorder, error) {
+	var o recorderOptions
+	for _, optFn := range optFns {
+		optFn(&o)
+	}
+
+	if transport == nil {
+		transport = realTransport()
+	}
+
+	scrubber := NewScrubber(o.scrubConfig)
+	opts := []recorder.Option{
+		recorder.WithHook(sensitiveHeaderHook, recorder.AfterCaptureHook),
+		recorder.WithHook(newInteractionHook, recorder.AfterCaptureHook),
+		// Only interactions recorded in this session need scrubbing, those loaded from the cassette already are.
+		recorder.WithHook(forNewInteractions(scrubber.scrubHook, true), recorder.BeforeSaveHook),
+		// Responses being recorded are returned as is.
+		recorder.WithHook(forNewInteractions(scrubber.restoreHook, false), recorder.BeforeResponseReplayHook),
+		recorder.WithMatcher(matcher(ctx, scrubber)),
+		recorder.WithMode(mode),
+		recorder.WithRealTransport(transport),
+		recorder.WithSkipRequestLatency(true),
+	}
+	if o.strictReplay && mode == recorder.ModeReplayOnly {
+		opts = append(opts, recorder.WithHook(unusedInteractionHook, recorder.OnRecorderStopHook))
+	}
+
+	return recorder.New(cassetteName, opts...)
+}
+
+// realTransport returns the transport used for real AWS API calls.
+// Real transport config, cribbed from aws-sdk-go-base.
+func realTransport() http.RoundTripper {
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.MaxIdleConnsPerHost = 10
+	if tlsConfig := transport.TLSClientConfig; tlsConfig == nil {
+		tlsConfig = &tls.Config{
+			MinVersion: tls.VersionTLS13,
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return transport
+}
+
+// sensitiveHeaderHook is an after capture hook to remove sensitive HTTP headers.
+func sensitiveHeaderHook(i *cassette.Interaction) error {
+	delete(i.Request.Headers, "Authorization")
+	delete(i.Request.Headers, "X-Amz-Security-Token")
+	return nil
+}
+
+// newInteractionHeader marks the requests of interactions recorded, rather than loaded from the cassette,
+// in this session. It is removed before the cassette is saved.
+const newInteractionHeader = "X-Vcr-New-Interaction"
+
+// newInteractionHook is an after capture hook to mark newly recorded interactions.
+func newInteractionHook(i *cassette.Interaction) error {
+	if i.Request.Headers == nil {
+		i.Request.Headers = make(http.Header)
+	}
+	i.Request.Headers.Set(newInteractionHeader, "true")
+	return nil
+}
+
+// isNewInteraction returns whether the specified request was recorded in this session.
+func isNewInteraction(r cassette.Request) bool {
+	return r.Headers.Get(newInteractionHeader) != ""
+}
+
+// forNewInteractions returns a hook that applies the specified hook only to interactions that were (or weren't)
+// recorded in this session. The new interaction mark is removed from saved interactions.
+func forNewInteractions(hook recorder.HookFunc, isNew bool) recorder.HookFunc {
+	return func(i *cassette.Interaction) error {
+		if isNewInteraction(i.Request) != isNew {
+			return nil
+		}
+
+		if isNew {
+			i.Request.Headers.Del(newInteractionHeader)
+		}
+
+		return hook(i)
+	}
+}
+
+// unusedInteractionHook is an on recorder stop hook that fails for interactions that weren't replayed.
+func unusedInteractionHook(i *cassette.Interaction) error {
+	if !i.WasReplayed() {
+		return fmt.Errorf("recorded interaction %d (%s %s) was not replayed, the cassette may be stale", i.ID, i.Request.Method, i.Request.URL)
+	}
+
+	return nil
+}
+
+// matcher defines how VCR will match requests to stored interactions.
+// Requests are scrubbed before being compared to the scrubbed stored interactions.
+func matcher(ctx context.Context, scrubber *Scrubber) recorder.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		// Don't replay interactions recorded in this session, e.g. when polling for a state change.
+		if isNewInteraction(i) {
+			return false
+		}
+
+		if !urlsMatch(scrubber.Scrub(r.URL.String()), i.URL) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := scrubber.Scrub(b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		// The body might be the same, but encoded differently. Try decoding and comparing.
+		return bodiesMatch(ctx, r.Header.Get("Content-Type"), body, i.Body)
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+func TestRecorderReplaysOffline(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	cassetteName := filepath.Join(t.TempDir(), "cassette")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		io.WriteString(w, `{"QueueUrl":"https://sqs.us-west-2.amazonaws.com/123456789012/q"}`) //lintignore:AWSAT003
+	}))
+
+	do := func(t *testing.T, client *http.Client, body string) string {
+		t.Helper()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+		request.Header.Set("Authorization", "secret")
+
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+
+		b, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(b)
+	}
+
+	r, err := NewRecorder(ctx, cassetteName, recorder.ModeRecordOnly, server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := do(t, &http.Client{Transport: r}, `{"b":2,"a":1}`)
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	server.Close()
+
+	// Replay with the server gone and the request body reordered.
+	r, err = NewRecorder(ctx, cassetteName, recorder.ModeReplayOnly, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := do(t, &http.Client{Transport: r}, `{"a":1,"b":2}`); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestRecorderReplaysOrRecords(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	cassetteName := filepath.Join(t.TempDir(), "cassette")
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		fmt.Fprintf(w, `{"Call":%d}`, calls.Add(1))
+	}))
+	defer server.Close()
+
+	do := func(t *testing.T, client *http.Client, body string) string {
+		t.Helper()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+
+		b, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(b)
+	}
+
+	r, err := NewRecorder(ctx, cassetteName, recorder.ModeRecordOnly, server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	do(t, &http.Client{Transport: r}, `{"Name":"a"}`)
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = NewRecorder(ctx, cassetteName, recorder.ModeReplayWithNewEpisodes, server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: r}
+	for _, v := range []struct {
+		body string
+		want string
+	}{
+		{body: `{"Name":"a"}`, want: `{"Call":1}`}, // Replayed.
+		{body: `{"Name":"b"}`, want: `{"Call":2}`}, // Recorded.
+		{body: `{"Name":"b"}`, want: `{"Call":3}`}, // Recorded, not replayed from the previous call.
+	} {
+		if got := do(t, client, v.body); got != v.want {
+			t.Errorf("got %s, want %s", got, v.want)
+		}
+	}
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cassette.Load(cassetteName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(c.Interactions), 3; got != want {
+		t.Fatalf("%d interactions, want %d", got, want)
+	}
+	for _, i := range c.Interactions {
+		if isNewInteraction(i.Request) {
+			t.Errorf("interaction %d saved with %s header", i.ID, newInteractionHeader)
+		}
+	}
+}
+
+func TestRecorderStrictReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	cassetteName := filepath.Join(t.TempDir(), "cassette")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		io.WriteString(w, `{}`)
+	}))
+
+	do := func(t *testing.T, client *http.Client, body string) {
+		t.Helper()
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		response.Body.Close()
+	}
+
+	r, err := NewRecorder(ctx, cassetteName, recorder.ModeRecordOnly, server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: r}
+	do(t, client, `{"Name":"a"}`)
+	do(t, client, `{"Name":"b"}`)
+	if err := r.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	server.Close()
+
+	testCases := []struct {
+		name    string
+		optFns  []RecorderOption
+		bodies  []string
+		wantErr bool
+	}{
+		{
+			name:   "all replayed",
+			optFns: []RecorderOption{WithStrictReplay()},
+			bodies: []string{`{"Name":"a"}`, `{"Name":"b"}`},
+		},
+		{
+			name:    "unused interaction",
+			optFns:  []RecorderOption{WithStrictReplay()},
+			bodies:  []string{`{"Name":"a"}`},
+			wantErr: true,
+		},
+		{
+			name:   "unused interaction not strict",
+			bodies: []string{`{"Name":"a"}`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := NewRecorder(ctx, cassetteName, recorder.ModeReplayOnly, nil, testCase.optFns...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client := &http.Client{Transport: r}
+			for _, body := range testCase.bodies {
+				do(t, client, body)
+			}
+
+			if err := r.Stop(); (err != nil) != testCase.wantErr {
+				t.Errorf("Stop() error = %v, wantErr %t", err, testCase.wantErr)
+			}
+		})
+	}
+}
@@ -4216,11 +4216,16 @@ service "iam" {
     correct = "aws_iam_"
   }
 
+  tag_limits {
+    per_resource = 50
+  }
+
   provider_package_correct = "iam"
   doc_prefix               = ["iam_"]
   brand                    = "AWS"
 
-  is_global = true
+  is_global                 = true
+  tag_keys_case_insensitive = true
 }
 
 service "inspector" {
@@ -4777,6 +4782,11 @@ service "kinesis" {
     correct = "aws_kinesis_"
   }
 
+  tag_limits {
+    per_call     = 10
+    per_resource = 50
+  }
+
   provider_package_correct = "kinesis"
   doc_prefix               = ["kinesis_stream", "kinesis_resource_policy"]
   brand                    = "AWS"
@@ -5031,6 +5041,10 @@ service "lambda" {
     correct = "aws_lambda_"
   }
 
+  tag_limits {
+    per_resource = 50
+  }
+
   provider_package_correct = "lambda"
   doc_prefix               = ["lambda_"]
   brand                    = "AWS"
@@ -7281,6 +7295,10 @@ service "route53" {
     correct = "aws_route53_"
   }
 
+  tag_limits {
+    per_call = 10
+  }
+
   provider_package_correct = "route53"
   doc_prefix               = ["route53_cidr_", "route53_delegation_", "route53_health_", "route53_hosted_", "route53_key_", "route53_query_", "route53_record", "route53_traffic_", "route53_vpc_", "route53_zone"]
   brand                    = "AWS"
@@ -9313,6 +9331,10 @@ service "dynamodb" {
     correct = "aws_dynamodb_"
   }
 
+  tag_limits {
+    per_resource = 50
+  }
+
   provider_package_correct = "dynamodb"
   doc_prefix               = ["dynamodb_"]
   brand                    = "AWS"
@@ -9338,6 +9360,10 @@ service "ec2" {
     correct = "aws_ec2_"
   }
 
+  tag_limits {
+    per_resource = 50
+  }
+
   sub_service "ec2ebs" {
     cli_v2_command {
       aws_cli_v2_command           = ""
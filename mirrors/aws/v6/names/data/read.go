@@ -177,6 +177,24 @@ func (sr ServiceRecord) IsGlobal() bool {
 	return sr.service.IsGlobal
 }
 
+func (sr ServiceRecord) TagKeysCaseInsensitive() bool {
+	return sr.service.TagKeysCaseInsensitive
+}
+
+func (sr ServiceRecord) TagsPerCallLimit() int {
+	if sr.service.ServiceTagLimits != nil {
+		return sr.service.ServiceTagLimits.PerCall
+	}
+	return 0
+}
+
+func (sr ServiceRecord) TagsPerResourceLimit() int {
+	if sr.service.ServiceTagLimits != nil {
+		return sr.service.ServiceTagLimits.PerResource
+	}
+	return 0
+}
+
 func (sr ServiceRecord) NotImplemented() bool {
 	return sr.service.NotImplemented
 }
@@ -334,6 +352,11 @@ type EndpointInfo struct {
 	EndpointOnly            bool              `hcl:"endpoint_only,optional"`
 }
 
+type TagLimits struct {
+	PerCall     int `hcl:"per_call,optional"`
+	PerResource int `hcl:"per_resource,optional"`
+}
+
 type Service struct {
 	ProviderPackage       string         `hcl:",label"`
 	ServiceCli            *CLIV2Command  `hcl:"cli_v2_command,block"`
@@ -344,6 +367,7 @@ type Service struct {
 	ServiceEnvVars        *EnvVar        `hcl:"env_var,block"`
 	ServiceEndpoints      *EndpointInfo  `hcl:"endpoint_info,block"`
 	ServiceResourcePrefix ResourcePrefix `hcl:"resource_prefix,block"`
+	ServiceTagLimits      *TagLimits     `hcl:"tag_limits,block"`
 
 	SubService []Service `hcl:"sub_service,block"`
 
@@ -357,6 +381,7 @@ type Service struct {
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`
 	Note                          string   `hcl:"note,optional"`
 	IsGlobal                      bool     `hcl:"is_global,optional"`
+	TagKeysCaseInsensitive        bool     `hcl:"tag_keys_case_insensitive,optional"`
 }
 
 type Services struct {
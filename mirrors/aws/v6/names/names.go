@@ -170,10 +170,12 @@ func PartitionForRegion(region string) endpoints.Partition {
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
-	aliases           []string
-	brand             string
-	humanFriendly     string
-	providerNameUpper string
+	aliases                []string
+	brand                  string
+	humanFriendly          string
+	providerNameUpper      string
+	tagKeysCaseInsensitive bool
+	tagsPerResourceLimit   int
 }
 
 // serviceData key is the AWS provider service package
@@ -209,9 +211,11 @@ func readHCLIntoServiceData() error {
 		p := l.ProviderPackage()
 
 		sd := serviceDatum{
-			brand:             l.Brand(),
-			humanFriendly:     l.HumanFriendly(),
-			providerNameUpper: l.ProviderNameUpper(),
+			brand:                  l.Brand(),
+			humanFriendly:          l.HumanFriendly(),
+			providerNameUpper:      l.ProviderNameUpper(),
+			tagKeysCaseInsensitive: l.TagKeysCaseInsensitive(),
+			tagsPerResourceLimit:   l.TagsPerResourceLimit(),
 		}
 
 		a := []string{p}
@@ -296,6 +300,24 @@ func HumanFriendly(service string) (string, error) {
 	return "", fmt.Errorf("no service data found for %s", service)
 }
 
+// TagKeysCaseInsensitive returns whether the service treats resource tag keys that differ only in case as the same key.
+func TagKeysCaseInsensitive(service string) bool {
+	if v, ok := serviceData[service]; ok {
+		return v.tagKeysCaseInsensitive
+	}
+
+	return false
+}
+
+// TagsPerResourceLimit returns the maximum number of tags on a resource of the service, or 0 if not known.
+func TagsPerResourceLimit(service string) int {
+	if v, ok := serviceData[service]; ok {
+		return v.tagsPerResourceLimit
+	}
+
+	return 0
+}
+
 const (
 	ResourceTopLevelRegionAttributeDescription     = `Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
 	ListResourceTopLevelRegionAttributeDescription = `Region to [query](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints) for resources of this type. ` + topLevelRegionDefaultDescription
@@ -0,0 +1,388 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"slices"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
+	tfunique "github.com/blampe/patches/mirrors/aws/v6/internal/unique"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+// Kind is the kind of Terraform type implemented by a service package.
+type Kind int
+
+const (
+	KindResource Kind = iota
+	KindDataSource
+	KindEphemeralResource
+	KindListResource
+	KindAction
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindResource:
+		return "resource"
+	case KindDataSource:
+		return "data source"
+	case KindEphemeralResource:
+		return "ephemeral resource"
+	case KindListResource:
+		return "list resource"
+	case KindAction:
+		return "action"
+	default:
+		return "unknown"
+	}
+}
+
+// Implementation is the Terraform plugin library used to implement a type.
+type Implementation int
+
+const (
+	ImplementationSDKv2 Implementation = iota
+	ImplementationFramework
+)
+
+func (i Implementation) String() string {
+	switch i {
+	case ImplementationSDKv2:
+		return "SDKv2"
+	case ImplementationFramework:
+		return "Framework"
+	default:
+		return "unknown"
+	}
+}
+
+// RegionMode describes a type's support for per-resource Region override.
+type RegionMode int
+
+const (
+	// RegionModeNone indicates that the type has no `region` attribute.
+	RegionModeNone RegionMode = iota
+	// RegionModeOverride indicates that the type has an injected top-level `region` attribute.
+	RegionModeOverride
+	// RegionModeOverrideValidated indicates that the `region` attribute value is validated against the configured partition.
+	RegionModeOverrideValidated
+)
+
+func (m RegionMode) String() string {
+	switch m {
+	case RegionModeNone:
+		return "none"
+	case RegionModeOverride:
+		return "override"
+	case RegionModeOverrideValidated:
+		return "override (validated)"
+	default:
+		return "unknown"
+	}
+}
+
+// TagsInfo describes a type's transparent tagging support.
+type TagsInfo struct {
+	// IdentifierAttribute is the attribute holding the identifier passed to the AWS tagging APIs.
+	IdentifierAttribute string
+	// ResourceType is any extra resource type parameter passed to the AWS tagging APIs.
+	ResourceType string
+}
+
+// IdentityAttributeInfo describes a resource identity attribute.
+type IdentityAttributeInfo struct {
+	Name                  string
+	Required              bool
+	ResourceAttributeName string
+}
+
+// IdentityInfo describes a type's resource identity.
+type IdentityInfo struct {
+	Attributes        []IdentityAttributeInfo
+	HasInherentRegion bool
+	IsARN             bool
+	IsGlobal          bool
+	IsMutable         bool
+	IsSingleton       bool
+	Version           int64
+}
+
+// TypeInfo describes a Terraform type implemented by a service package.
+type TypeInfo struct {
+	TypeName       string
+	Kind           Kind
+	Implementation Implementation
+	// Name is the human-friendly name of the type, e.g. "Bucket".
+	Name string
+	// ServicePackageName is the service package name, a constant in the `names` package.
+	ServicePackageName string
+	// Tags is nil if the type does not support transparent tagging.
+	Tags   *TagsInfo
+	Region RegionMode
+	// Identity is nil if the type does not support resource identity.
+	Identity *IdentityInfo
+	// Importable is true if the resource supports `terraform import`.
+	Importable bool
+	// HasListResource is true if a list resource with the same type name exists.
+	HasListResource bool
+	// HasEphemeralResource is true if an ephemeral resource with the same type name exists.
+	HasEphemeralResource bool
+}
+
+// ServicePackageInfo describes a service package.
+type ServicePackageInfo struct {
+	// Name is the service package name, a constant in the `names` package.
+	Name string
+	// HumanFriendly is the service's full human-friendly name from `names/data`, e.g. "Amazon S3 (Simple Storage)".
+	HumanFriendly string
+	// ProviderNameUpper is the service's upper camel-case name, e.g. "S3".
+	ProviderNameUpper string
+	Types             []*TypeInfo
+}
+
+// Catalog is a read-only description of the types implemented by the registered service packages.
+type Catalog struct {
+	servicePackages []*ServicePackageInfo
+	types           map[string][]*TypeInfo
+}
+
+// ServicePackages returns all service packages, ordered by name.
+func (c *Catalog) ServicePackages() iter.Seq[*ServicePackageInfo] {
+	return slices.Values(c.servicePackages)
+}
+
+// Lookup returns all types with the specified type name, e.g. both a resource and a data source.
+func (c *Catalog) Lookup(typeName string) []*TypeInfo {
+	return slices.Clone(c.types[typeName])
+}
+
+// LookupKind returns the type of the specified kind with the specified type name.
+func (c *Catalog) LookupKind(typeName string, kind Kind) (*TypeInfo, bool) {
+	for _, v := range c.types[typeName] {
+		if v.Kind == kind {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// Catalog returns a description of the types implemented by the provider's registered service packages.
+func (p UpstreamProvider) Catalog(ctx context.Context) *Catalog {
+	c := p.SDKV2Provider.Meta().(*conns.AWSClient)
+	return newCatalog(ctx, c.ServicePackages(ctx), p.SDKV2Provider.ResourcesMap)
+}
+
+func newCatalog(ctx context.Context, servicePackages iter.Seq[conns.ServicePackage], sdkResources map[string]*schema.Resource) *Catalog {
+	catalog := &Catalog{
+		types: make(map[string][]*TypeInfo),
+	}
+
+	for sp := range servicePackages {
+		servicePackageName := sp.ServicePackageName()
+		spInfo := &ServicePackageInfo{
+			Name: servicePackageName,
+		}
+		if v, err := names.FullHumanFriendly(servicePackageName); err == nil {
+			spInfo.HumanFriendly = v
+		}
+		if v, err := names.ProviderNameUpper(servicePackageName); err == nil {
+			spInfo.ProviderNameUpper = v
+		}
+
+		add := func(v *TypeInfo) {
+			v.ServicePackageName = servicePackageName
+			spInfo.Types = append(spInfo.Types, v)
+			catalog.types[v.TypeName] = append(catalog.types[v.TypeName], v)
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			var importable bool
+			if r, ok := sdkResources[v.TypeName]; ok {
+				// SDKv2 resources are built on first use.
+				r.SchemaMap()
+				importable = r.Importer != nil
+			} else {
+				importable = v.Import.WrappedImport || v.Import.CustomImport
+			}
+			add(&TypeInfo{
+				TypeName:       v.TypeName,
+				Kind:           KindResource,
+				Implementation: ImplementationSDKv2,
+				Name:           v.Name,
+				Tags:           newTagsInfo(v.Tags),
+				Region:         newRegionMode(v.Region),
+				Identity:       newIdentityInfo(v.Identity),
+				Importable:     importable,
+			})
+		}
+
+		for _, v := range sp.SDKDataSources(ctx) {
+			add(&TypeInfo{
+				TypeName:       v.TypeName,
+				Kind:           KindDataSource,
+				Implementation: ImplementationSDKv2,
+				Name:           v.Name,
+				Tags:           newTagsInfo(v.Tags),
+				Region:         newRegionMode(v.Region),
+			})
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			add(&TypeInfo{
+				TypeName:       v.TypeName,
+				Kind:           KindResource,
+				Implementation: ImplementationFramework,
+				Name:           v.Name,
+				Tags:           newTagsInfo(v.Tags),
+				Region:         newRegionMode(v.Region),
+				Identity:       newIdentityInfo(v.Identity),
+				Importable:     v.Import.ImportState || v.Import.WrappedImport,
+			})
+		}
+
+		for _, v := range sp.FrameworkDataSources(ctx) {
+			add(&TypeInfo{
+				TypeName:       v.TypeName,
+				Kind:           KindDataSource,
+				Implementation: ImplementationFramework,
+				Name:           v.Name,
+				Tags:           newTagsInfo(v.Tags),
+				Region:         newRegionMode(v.Region),
+			})
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithEphemeralResources); ok {
+			for _, v := range sp.EphemeralResources(ctx) {
+				add(&TypeInfo{
+					TypeName:       v.TypeName,
+					Kind:           KindEphemeralResource,
+					Implementation: ImplementationFramework,
+					Name:           v.Name,
+					Region:         newRegionMode(v.Region),
+				})
+			}
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithSDKListResources); ok {
+			for v := range sp.SDKListResources(ctx) {
+				add(&TypeInfo{
+					TypeName:       v.TypeName,
+					Kind:           KindListResource,
+					Implementation: ImplementationSDKv2,
+					Name:           v.Name,
+					Tags:           newTagsInfo(v.Tags),
+					Region:         newRegionMode(v.Region),
+					Identity:       newIdentityInfo(v.Identity),
+				})
+			}
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithFrameworkListResources); ok {
+			for v := range sp.FrameworkListResources(ctx) {
+				add(&TypeInfo{
+					TypeName:       v.TypeName,
+					Kind:           KindListResource,
+					Implementation: ImplementationFramework,
+					Name:           v.Name,
+					Tags:           newTagsInfo(v.Tags),
+					Region:         newRegionMode(v.Region),
+					Identity:       newIdentityInfo(v.Identity),
+				})
+			}
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithActions); ok {
+			for _, v := range sp.Actions(ctx) {
+				add(&TypeInfo{
+					TypeName:       v.TypeName,
+					Kind:           KindAction,
+					Implementation: ImplementationFramework,
+					Name:           v.Name,
+					Region:         newRegionMode(v.Region),
+				})
+			}
+		}
+
+		slices.SortFunc(spInfo.Types, func(a, b *TypeInfo) int {
+			return cmp.Or(cmp.Compare(a.TypeName, b.TypeName), cmp.Compare(a.Kind, b.Kind))
+		})
+		catalog.servicePackages = append(catalog.servicePackages, spInfo)
+	}
+
+	slices.SortFunc(catalog.servicePackages, func(a, b *ServicePackageInfo) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	for _, types := range catalog.types {
+		var hasList, hasEphemeral bool
+		for _, v := range types {
+			switch v.Kind {
+			case KindListResource:
+				hasList = true
+			case KindEphemeralResource:
+				hasEphemeral = true
+			}
+		}
+		for _, v := range types {
+			if v.Kind == KindResource {
+				v.HasListResource = hasList
+				v.HasEphemeralResource = hasEphemeral
+			}
+		}
+	}
+
+	return catalog
+}
+
+func newTagsInfo(v unique.Handle[inttypes.ServicePackageResourceTags]) *TagsInfo {
+	if tfunique.IsHandleNil(v) {
+		return nil
+	}
+	return &TagsInfo{
+		IdentifierAttribute: v.Value().IdentifierAttribute,
+		ResourceType:        v.Value().ResourceType,
+	}
+}
+
+func newRegionMode(v unique.Handle[inttypes.ServicePackageResourceRegion]) RegionMode {
+	if tfunique.IsHandleNil(v) || !v.Value().IsOverrideEnabled {
+		return RegionModeNone
+	}
+	if v.Value().IsValidateOverrideInPartition {
+		return RegionModeOverrideValidated
+	}
+	return RegionModeOverride
+}
+
+func newIdentityInfo(v inttypes.Identity) *IdentityInfo {
+	if len(v.Attributes) == 0 {
+		return nil
+	}
+
+	attributes := make([]IdentityAttributeInfo, 0, len(v.Attributes))
+	for _, attr := range v.Attributes {
+		attributes = append(attributes, IdentityAttributeInfo{
+			Name:                  attr.Name(),
+			Required:              attr.Required(),
+			ResourceAttributeName: attr.ResourceAttributeName(),
+		})
+	}
+
+	return &IdentityInfo{
+		Attributes:        attributes,
+		IsARN:             v.IsARN,
+		IsGlobal:          v.IsGlobalResource,
+		IsMutable:         v.IsMutable,
+		IsSingleton:       v.IsSingleton,
+		HasInherentRegion: v.HasInherentRegion(),
+		Version:           v.Version(),
+	}
+}
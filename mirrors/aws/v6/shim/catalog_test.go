@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package shim
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/conns"
+	inttypes "github.com/blampe/patches/mirrors/aws/v6/internal/types"
+	"github.com/blampe/patches/mirrors/aws/v6/names"
+)
+
+type mockServicePackage struct{}
+
+func (mockServicePackage) FrameworkDataSources(context.Context) []*inttypes.ServicePackageFrameworkDataSource {
+	return nil
+}
+
+func (mockServicePackage) FrameworkResources(context.Context) []*inttypes.ServicePackageFrameworkResource {
+	factory := func(context.Context) (resource.ResourceWithConfigure, error) {
+		return nil, errors.New("factory called")
+	}
+
+	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  factory,
+			TypeName: "aws_s3_directory_bucket",
+			Name:     "Directory Bucket",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Import: inttypes.FrameworkImport{
+				ImportState: true,
+			},
+		},
+		{
+			Factory:  factory,
+			TypeName: "aws_s3_bucket_abac",
+			Name:     "Bucket ABAC",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
+func (mockServicePackage) SDKDataSources(context.Context) []*inttypes.ServicePackageSDKDataSource {
+	return []*inttypes.ServicePackageSDKDataSource{
+		{
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
+func (mockServicePackage) SDKResources(context.Context) []*inttypes.ServicePackageSDKResource {
+	return []*inttypes.ServicePackageSDKResource{
+		{
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrBucket,
+				ResourceType:        "Bucket",
+			}),
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+			Identity: inttypes.RegionalSingleParameterIdentity(names.AttrBucket),
+		},
+	}
+}
+
+func (mockServicePackage) SDKListResources(context.Context) iter.Seq[*inttypes.ServicePackageSDKListResource] {
+	return slices.Values([]*inttypes.ServicePackageSDKListResource{
+		{
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+		},
+	})
+}
+
+func (mockServicePackage) ServicePackageName() string {
+	return names.S3
+}
+
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	sdkResources := map[string]*schema.Resource{
+		"aws_s3_bucket": {
+			Importer: &schema.ResourceImporter{},
+		},
+	}
+	catalog := newCatalog(ctx, slices.Values([]conns.ServicePackage{mockServicePackage{}}), sdkResources)
+
+	sps := slices.Collect(catalog.ServicePackages())
+	if got, want := len(sps), 1; got != want {
+		t.Fatalf("service packages: got %d, want %d", got, want)
+	}
+	if got, want := sps[0].ProviderNameUpper, "S3"; got != want {
+		t.Errorf("ProviderNameUpper: got %q, want %q", got, want)
+	}
+	if got, want := len(catalog.Lookup("aws_s3_bucket")), 3; got != want {
+		t.Errorf("types: got %d, want %d", got, want)
+	}
+
+	v, ok := catalog.LookupKind("aws_s3_bucket", KindResource)
+	if !ok {
+		t.Fatal("resource not found")
+	}
+	if got, want := v.Implementation, ImplementationSDKv2; got != want {
+		t.Errorf("Implementation: got %s, want %s", got, want)
+	}
+	if v.Tags == nil || v.Tags.IdentifierAttribute != names.AttrBucket {
+		t.Errorf("Tags: got %+v", v.Tags)
+	}
+	if got, want := v.Region, RegionModeOverrideValidated; got != want {
+		t.Errorf("Region: got %s, want %s", got, want)
+	}
+	if v.Identity == nil || v.Identity.IsGlobal || len(v.Identity.Attributes) != 3 {
+		t.Errorf("Identity: got %+v", v.Identity)
+	}
+	if !v.Importable {
+		t.Error("expected resource to be importable")
+	}
+	if !v.HasListResource {
+		t.Error("expected resource to have a list resource")
+	}
+	if v.HasEphemeralResource {
+		t.Error("expected resource not to have an ephemeral resource")
+	}
+
+	v, ok = catalog.LookupKind("aws_s3_bucket", KindDataSource)
+	if !ok {
+		t.Fatal("data source not found")
+	}
+	if v.Tags != nil {
+		t.Errorf("Tags: got %+v, want nil", v.Tags)
+	}
+
+	for typeName, want := range map[string]bool{
+		"aws_s3_directory_bucket": true,
+		"aws_s3_bucket_abac":      false,
+	} {
+		v, ok := catalog.LookupKind(typeName, KindResource)
+		if !ok {
+			t.Fatalf("%s: resource not found", typeName)
+		}
+		if got := v.Importable; got != want {
+			t.Errorf("%s: Importable: got %t, want %t", typeName, got, want)
+		}
+	}
+}
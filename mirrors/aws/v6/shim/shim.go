@@ -2,32 +2,237 @@ package shim
 
 import (
 	"context"
+	"net/http"
 
 	pfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/framework"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/initreport"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/provider/sdkv2"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/retry"
 	"github.com/blampe/patches/mirrors/aws/v6/internal/tags"
+	"github.com/blampe/patches/mirrors/aws/v6/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
 
 type UpstreamProvider struct {
+	// SDKV2Provider's data sources and resources are built on first use.
+	// Call a data source's or resource's SchemaMap method before reading any of its other fields.
 	SDKV2Provider           *schema.Provider
 	PluginFrameworkProvider pfprovider.Provider
+	// InitializationReport lists the problems found while initializing the providers.
+	// Only problems that didn't fail initialization are present, e.g. warnings with InitializationLenient.
+	InitializationReport *InitializationReport
+
+	recorder *recorder.Recorder
+}
+
+// Close stops recording or replaying AWS API calls, saving any recorded interactions to the cassette.
+// It is a no-op unless the provider was created with WithCassette.
+func (p UpstreamProvider) Close() error {
+	if p.recorder == nil {
+		return nil
+	}
+	return p.recorder.Stop()
+}
+
+// Option configures NewUpstreamProvider.
+type Option func(*options)
+
+type options struct {
+	framework     []framework.ProviderOption
+	sdkv2         []sdkv2.ProviderOption
+	httpTransport http.RoundTripper
+	cassette      *cassetteOptions
+	scrubbing     CassetteScrubbing
+	strictReplay  bool
+	strictness    InitializationStrictness
+}
+
+type cassetteOptions struct {
+	name string
+	mode CassetteMode
+}
+
+// CassetteScrubbing configures the replacement of sensitive values, such as account IDs, in recorded interactions.
+type CassetteScrubbing = vcr.ScrubConfig
+
+// CassetteScrubPattern replaces matches of a regular expression in recorded interactions.
+type CassetteScrubPattern = vcr.ScrubPattern
+
+// TagsAllMode determines how a resource's `tags_all` attribute is managed.
+type TagsAllMode = tags.TagsAllMode
+
+const (
+	// TagsAllUpstreamComputed leaves `tags_all` Computed by the provider. This is the default.
+	TagsAllUpstreamComputed = tags.TagsAllUpstreamComputed
+	// TagsAllCallerManaged makes the `tags_all` schema match `tags` for callers that copy `tags` into `tags_all`.
+	TagsAllCallerManaged = tags.TagsAllCallerManaged
+)
+
+// CassetteMode determines whether AWS API interactions are recorded to, or replayed from, a cassette.
+type CassetteMode = recorder.Mode
+
+const (
+	// CassetteRecordOnly makes real AWS API calls and records the interactions.
+	CassetteRecordOnly = recorder.ModeRecordOnly
+	// CassetteReplayOnly replays recorded interactions, failing any AWS API call that wasn't recorded.
+	CassetteReplayOnly = recorder.ModeReplayOnly
+	// CassetteReplayOrRecord replays recorded interactions and makes real AWS API calls for, and records,
+	// any others. Use it to add the calls of a changed resource to an existing cassette.
+	CassetteReplayOrRecord = recorder.ModeReplayWithNewEpisodes
+)
+
+// InitializationReport is an aggregated report of problems with resources, data sources etc. found while
+// initializing the providers. NewUpstreamProvider returns an *InitializationReport error if initialization fails.
+type InitializationReport = initreport.Report
+
+// InitializationIssue is a problem with a single resource, data source etc.
+type InitializationIssue = initreport.Issue
+
+// InitializationStrictness determines which problems cause provider initialization to fail.
+type InitializationStrictness = initreport.Strictness
+
+const (
+	// InitializationStrict fails initialization on any problem. This is the default.
+	InitializationStrict = initreport.Strict
+	// InitializationLenient fails initialization only on problems that make a resource, data source etc. unusable.
+	// Other problems are reported as warnings in UpstreamProvider.InitializationReport.
+	InitializationLenient = initreport.Lenient
+)
+
+// WaiterProgress describes a state transition observed by a long-running waiter, e.g. while an RDS cluster is created.
+type WaiterProgress = retry.StateChangeProgress
+
+// WithWaiterProgress returns a copy of the specified context on which long-running waiters report each observed
+// state transition, the elapsed time, the remaining timeout and the latest raw status to the specified function.
+// Pass the returned context to resource operations to forward waiter progress to users.
+func WithWaiterProgress(ctx context.Context, f func(context.Context, WaiterProgress)) context.Context {
+	return retry.NewProgressContext(ctx, f)
+}
+
+// WithServicePackages registers only the named service packages (the `names` constants, e.g. `names.S3`)
+// with both the SDKv2 and Plugin Framework providers.
+func WithServicePackages(servicePackageNames ...string) Option {
+	return func(o *options) {
+		o.sdkv2 = append(o.sdkv2, sdkv2.WithServicePackages(servicePackageNames...))
+	}
+}
+
+// WithoutServicePackages prevents the named service packages from being registered
+// with either the SDKv2 or Plugin Framework providers.
+func WithoutServicePackages(servicePackageNames ...string) Option {
+	return func(o *options) {
+		o.sdkv2 = append(o.sdkv2, sdkv2.WithoutServicePackages(servicePackageNames...))
+	}
+}
+
+// WithTagsAllMode sets how `tags_all` is managed for both SDKv2 and Plugin Framework resources.
+func WithTagsAllMode(mode TagsAllMode) Option {
+	return func(o *options) {
+		o.framework = append(o.framework, framework.WithTagsAllMode(mode))
+		o.sdkv2 = append(o.sdkv2, sdkv2.WithTagsAllMode(mode))
+	}
+}
+
+// WithHTTPTransport routes all AWS API calls, including those made while configuring the provider
+// and by every service client, through the specified transport, e.g. to a local stand-in server.
+func WithHTTPTransport(transport http.RoundTripper) Option {
+	return func(o *options) {
+		o.httpTransport = transport
+	}
+}
+
+// WithCassette records AWS API calls to, or replays them from, the named cassette file
+// (without the ".yaml" extension). If WithHTTPTransport is also specified, interactions
+// are recorded using that transport.
+// UpstreamProvider.Close must be called to save recorded interactions.
+func WithCassette(cassetteName string, mode CassetteMode) Option {
+	return func(o *options) {
+		o.cassette = &cassetteOptions{
+			name: cassetteName,
+			mode: mode,
+		}
+	}
+}
+
+// WithCassetteScrubbing configures how sensitive values are scrubbed from interactions recorded with WithCassette.
+// Access key IDs, presigned URL signatures, secret values and account IDs in ARNs are always scrubbed.
+// The configured account IDs are restored when interactions are replayed.
+func WithCassetteScrubbing(scrubbing CassetteScrubbing) Option {
+	return func(o *options) {
+		o.scrubbing = scrubbing
+	}
+}
+
+// WithCassetteStrictReplay makes UpstreamProvider.Close fail if any interaction replayed with CassetteReplayOnly
+// wasn't used, e.g. because the cassette is stale.
+func WithCassetteStrictReplay() Option {
+	return func(o *options) {
+		o.strictReplay = true
+	}
+}
+
+// WithInitializationStrictness sets which problems found while initializing the providers cause NewUpstreamProvider to fail.
+func WithInitializationStrictness(strictness InitializationStrictness) Option {
+	return func(o *options) {
+		o.strictness = strictness
+	}
 }
 
-func NewUpstreamProvider(ctx context.Context) (UpstreamProvider, error) {
-	primary, err := sdkv2.NewProvider(ctx)
+// WithSchemaValidation validates SDKv2 and Plugin Framework resource, data source etc. schemas when the providers are created.
+// By default schemas are only materialized, and validated, when used. Validation problems are reported as initialization problems.
+func WithSchemaValidation() Option {
+	return func(o *options) {
+		o.framework = append(o.framework, framework.WithSchemaValidation())
+		o.sdkv2 = append(o.sdkv2, sdkv2.WithSchemaValidation())
+	}
+}
+
+func NewUpstreamProvider(ctx context.Context, opts ...Option) (UpstreamProvider, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	// A failed initialization returns the report as the error.
+	report := &InitializationReport{}
+	o.sdkv2 = append(o.sdkv2, sdkv2.WithInitializationReport(report, o.strictness))
+	o.framework = append(o.framework, framework.WithInitializationReport(report, o.strictness))
+
+	var r *recorder.Recorder
+	transport := o.httpTransport
+	if v := o.cassette; v != nil {
+		optFns := []vcr.RecorderOption{vcr.WithScrubConfig(o.scrubbing)}
+		if o.strictReplay {
+			optFns = append(optFns, vcr.WithStrictReplay())
+		}
+
+		var err error
+		r, err = vcr.NewRecorder(ctx, v.name, v.mode, transport, optFns...)
+		if err != nil {
+			return UpstreamProvider{}, err
+		}
+		transport = r
+	}
+	if transport != nil {
+		o.sdkv2 = append(o.sdkv2, sdkv2.WithHTTPTransport(transport))
+	}
+
+	// The Plugin Framework provider registers the service packages configured on the primary provider's meta.
+	primary, err := sdkv2.NewProvider(ctx, o.sdkv2...)
 	if err != nil {
 		return UpstreamProvider{}, err
 	}
-	pf, err := framework.NewProvider(ctx, primary)
+	pf, err := framework.NewProvider(ctx, primary, o.framework...)
 	if err != nil {
-		//lintignore:R009
-		panic(err)
+		return UpstreamProvider{}, err
 	}
 	return UpstreamProvider{
 		SDKV2Provider:           primary,
 		PluginFrameworkProvider: pf,
+		InitializationReport:    report,
+		recorder:                r,
 	}, nil
 }
 
@@ -70,6 +70,9 @@ func main() {
 			g.Fatalf("data source type %s not found", v)
 		}
 
+		// Data sources and resources are built on first use.
+		resource.SchemaMap()
+
 		migrator.IsDataSource = true
 		migrator.Resource = resource
 		migrator.Template = datasourceImpl
@@ -81,6 +84,9 @@ func main() {
 			g.Fatalf("resource type %s not found", v)
 		}
 
+		// Data sources and resources are built on first use.
+		resource.SchemaMap()
+
 		migrator.Resource = resource
 		migrator.Template = resourceImpl
 		migrator.TFTypeName = v